@@ -2,8 +2,10 @@ package cmd
 
 import (
 	"fmt"
+	"io/fs"
 	"os"
 
+	"github.com/nathanborror/startapp/def"
 	"github.com/nathanborror/startapp/gen"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -11,6 +13,9 @@ import (
 
 var configFlag string
 
+// Templates are the builtin project templates.
+var Templates fs.FS
+
 var RootCmd = &cobra.Command{
 	Use:   "startapp",
 	Short: "StartApp is a quick way to get started on a new App",
@@ -30,17 +35,31 @@ func init() {
 	RootCmd.PersistentFlags().StringVar(&configFlag, "config", "", "config file (default is ~/.startapp)")
 	RootCmd.PersistentFlags().String("domain", "", "The app's domain")
 	RootCmd.PersistentFlags().String("graphql-schema", "", "GraphQL schema")
+	RootCmd.PersistentFlags().String("module", "", "Go module path of the project (default is the app name)")
 	RootCmd.PersistentFlags().Bool("ios-backend-scaffolding", true, "Output iOS backend scaffolding")
 	RootCmd.PersistentFlags().Bool("ios-test-scaffolding", true, "Output iOS tests scaffolding")
 	RootCmd.PersistentFlags().String("ios-product-name", "", "iOS Product name")
 	RootCmd.PersistentFlags().String("ios-team-id", "", "iOS Team ID")
+	RootCmd.PersistentFlags().Bool("ios-swift-package", false, "Output the iOS Kit as a Swift package")
+	RootCmd.PersistentFlags().Bool("ios-xcodegen", true, "Output an XcodeGen project.yml for the iOS client")
+	RootCmd.PersistentFlags().String("android-product-name", "", "Android Product name, adds an Android client when set")
+	RootCmd.PersistentFlags().String("web-product-name", "", "Web Product name, adds a TypeScript web client when set")
+	RootCmd.PersistentFlags().String("templates", "", "Directory of templates that override or add to the builtin templates")
+	RootCmd.Flags().Bool("dry-run", false, "List the files that would be created, changed or skipped without writing them")
+	RootCmd.Flags().Bool("diff", false, "Print unified diffs against the files on disk without writing them")
 
 	viper.BindPFlag("domain", RootCmd.PersistentFlags().Lookup("domain"))
 	viper.BindPFlag("graphql-schema", RootCmd.PersistentFlags().Lookup("graphql-schema"))
+	viper.BindPFlag("module", RootCmd.PersistentFlags().Lookup("module"))
 	viper.BindPFlag("ios-backend-scaffolding", RootCmd.PersistentFlags().Lookup("ios-backend-scaffolding"))
 	viper.BindPFlag("ios-test-scaffolding", RootCmd.PersistentFlags().Lookup("ios-test-scaffolding"))
 	viper.BindPFlag("ios-product-name", RootCmd.PersistentFlags().Lookup("ios-product-name"))
 	viper.BindPFlag("ios-team-id", RootCmd.PersistentFlags().Lookup("ios-team-id"))
+	viper.BindPFlag("ios-swift-package", RootCmd.PersistentFlags().Lookup("ios-swift-package"))
+	viper.BindPFlag("ios-xcodegen", RootCmd.PersistentFlags().Lookup("ios-xcodegen"))
+	viper.BindPFlag("android-product-name", RootCmd.PersistentFlags().Lookup("android-product-name"))
+	viper.BindPFlag("web-product-name", RootCmd.PersistentFlags().Lookup("web-product-name"))
+	viper.BindPFlag("templates", RootCmd.PersistentFlags().Lookup("templates"))
 }
 
 func initConfig() {
@@ -63,7 +82,26 @@ func runGenerateApp(cmd *cobra.Command, args []string) {
 	name := args[0]
 	dest := args[1]
 	proj := gen.NewProject(name, dest, viper.GetString("domain"))
-	proj.AddIOSClient(viper.GetString("ios-product-name"), viper.GetString("ios-team-id"), viper.GetBool("ios-backend-scaffolding"), viper.GetBool("ios-test-scaffolding"))
+	proj.SetModule(viper.GetString("module"))
+	if diff, _ := cmd.Flags().GetBool("diff"); diff {
+		proj.SetOutputMode(gen.DiffMode)
+	} else if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+		proj.SetOutputMode(gen.DryRunMode)
+	}
+	proj.AddIOSClient(viper.GetString("ios-product-name"), viper.GetString("ios-team-id"), viper.GetBool("ios-backend-scaffolding"), viper.GetBool("ios-test-scaffolding"), viper.GetBool("ios-swift-package"), viper.GetBool("ios-xcodegen"))
+	if name := viper.GetString("android-product-name"); name != "" {
+		proj.AddAndroidClient(name)
+	}
+	if name := viper.GetString("web-product-name"); name != "" {
+		proj.AddWebClient(name)
+	}
+
+	var scalars map[string]def.ScalarDef
+	checkErr(viper.UnmarshalKey("scalars", &scalars))
+	proj.AddScalars(scalars)
+	proj.SetTemplates(Templates)
+	proj.SetTemplateDir(viper.GetString("templates"))
+
 	proj.ReadGraphQLSchema(viper.GetString("graphql-schema"))
 	proj.Copy(viper.GetString("graphql-schema"))
 	proj.Write()
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/nathanborror/startapp/gen"
+	"github.com/spf13/cobra"
+)
+
+var statusCmd = &cobra.Command{
+	Use:   "status [project-dir]",
+	Short: "Status reports generated files that were modified or removed",
+	Long: `Status compares the files recorded in a project's manifest with the
+files on disk and reports the ones that drifted from what was generated.`,
+	Args: cobra.MaximumNArgs(1),
+	Run:  runStatusCmd,
+}
+
+func init() {
+	RootCmd.AddCommand(statusCmd)
+}
+
+func runStatusCmd(cmd *cobra.Command, args []string) {
+	root := "."
+	if len(args) > 0 {
+		root = args[0]
+	}
+
+	manifest, err := gen.ReadManifest(root)
+	checkErr(err)
+	if len(manifest.Files) == 0 {
+		fmt.Printf("No generated files recorded in %s\n", root)
+		return
+	}
+
+	statuses := manifest.Status(root)
+	counts := make(map[string]int)
+	for _, status := range statuses {
+		counts[status.State]++
+		if status.State != gen.StateClean {
+			fmt.Printf("\t%s: %s (%s)\n", status.State, status.Filename, status.Source)
+		}
+	}
+	fmt.Printf("%d generated files: %d clean, %d modified, %d missing\n",
+		len(statuses), counts[gen.StateClean], counts[gen.StateModified], counts[gen.StateMissing])
+}
@@ -9,14 +9,15 @@ import (
 )
 
 type Definition struct {
-	Queries    []FuncDef
-	Mutations  []FuncDef
-	Scalars    []TypeDef
-	Objects    []TypeDef
-	Interfaces []TypeDef
-	Unions     []TypeDef
-	Enums      []TypeDef
-	Inputs     []TypeDef
+	Queries       []FuncDef
+	Mutations     []FuncDef
+	Subscriptions []FuncDef
+	Scalars       []TypeDef
+	Objects       []TypeDef
+	Interfaces    []TypeDef
+	Unions        []TypeDef
+	Enums         []TypeDef
+	Inputs        []TypeDef
 }
 
 type FuncDef struct {
@@ -26,8 +27,9 @@ type FuncDef struct {
 }
 
 type ArgDef struct {
-	Name string
-	Type TypeDef
+	Name       string
+	Type       TypeDef
+	HasDefault bool // Missing values are replaced by the default
 }
 
 type ArgDefs []ArgDef
@@ -40,14 +42,114 @@ type TypeDef struct {
 	IsOptional    bool
 	IsInterface   bool
 	IsEnum        bool
+	IsUnion       bool
+	IsConnection  bool
 	IsList        bool
+	OfType        *TypeDef // The element type when IsList is true
 	EnumValues    []string
 	PossibleTypes []TypeDef
 }
 
 type FieldDef struct {
-	Name string
-	Type TypeDef
+	Name      string
+	Arguments ArgDefs
+	Type      TypeDef
+}
+
+// ScalarDef maps a custom GraphQL scalar to the Go and Swift types that hold
+// its values and the codec functions that convert them to and from JSON.
+type ScalarDef struct {
+	Name        string `mapstructure:"-"`
+	GoType      string `mapstructure:"go-type"`      // e.g. time.Time
+	GoImport    string `mapstructure:"go-import"`    // Package GoType belongs to e.g. time
+	GoUnmarshal string `mapstructure:"go-unmarshal"` // func(input interface{}) (GoType, error)
+	GoMarshal   string `mapstructure:"go-marshal"`   // func(in GoType) ([]byte, error)
+	SwiftType   string `mapstructure:"swift-type"`   // e.g. Date
+	SwiftDecode string `mapstructure:"swift-decode"` // func(SingleValueDecodingContainer) throws -> SwiftType
+	SwiftEncode string `mapstructure:"swift-encode"` // func(SwiftType, inout SingleValueEncodingContainer) throws
+}
+
+// BuiltinScalars are the custom scalars that have codecs in the api and
+// iOS client templates. Any other custom scalar is treated as a String unless
+// it's configured. Swift types are qualified because the Swift scalar types
+// share their names.
+var BuiltinScalars = map[string]ScalarDef{
+	"Timestamp": newBuiltinScalar("Timestamp", "time.Time", "time", "Foundation.Date"),
+	"Date":      newBuiltinScalar("Date", "time.Time", "time", "Foundation.Date"),
+	"URL":       newBuiltinScalar("URL", "url.URL", "net/url", "Foundation.URL"),
+	"JSON":      newBuiltinScalar("JSON", "json.RawMessage", "encoding/json", "JSONValue"),
+	"UUID":      newBuiltinScalar("UUID", "string", "", "Foundation.UUID"),
+}
+
+// ConnectionDef describes a Relay style connection: an object named
+// `<Node>Connection` with an `edges` list of objects that have a `node` field.
+type ConnectionDef struct {
+	Type TypeDef // The connection e.g. AccountConnection
+	Edge TypeDef // The edge e.g. AccountEdge
+	Node TypeDef // The node e.g. Account
+}
+
+const connectionSuffix = "Connection"
+
+// NewScalar returns the ScalarDef for a custom scalar. Fields missing from
+// the given configuration fall back to the builtin scalar of the same name or
+// to a String. The fallback codecs can't convert another type, so a
+// configuration that changes the Go or Swift type must give its codecs.
+func NewScalar(name string, config ScalarDef) (ScalarDef, error) {
+	out, ok := BuiltinScalars[name]
+	if !ok {
+		out = newBuiltinScalar("String", "string", "", "String")
+	}
+	out.Name = name
+	if config.GoType != "" && config.GoType != out.GoType && (config.GoUnmarshal == "" || config.GoMarshal == "") {
+		return out, fmt.Errorf("scalar %s: go-type %s needs go-unmarshal and go-marshal", name, config.GoType)
+	}
+	if config.SwiftType != "" && config.SwiftType != out.SwiftType && (config.SwiftDecode == "" || config.SwiftEncode == "") {
+		return out, fmt.Errorf("scalar %s: swift-type %s needs swift-decode and swift-encode", name, config.SwiftType)
+	}
+	if config.GoType != "" {
+		out.GoType = config.GoType
+		out.GoImport = config.GoImport
+	}
+	if config.GoUnmarshal != "" {
+		out.GoUnmarshal = config.GoUnmarshal
+	}
+	if config.GoMarshal != "" {
+		out.GoMarshal = config.GoMarshal
+	}
+	if config.SwiftType != "" {
+		out.SwiftType = config.SwiftType
+	}
+	if config.SwiftDecode != "" {
+		out.SwiftDecode = config.SwiftDecode
+	}
+	if config.SwiftEncode != "" {
+		out.SwiftEncode = config.SwiftEncode
+	}
+	return out, nil
+}
+
+func newBuiltinScalar(name, goType, goImport, swiftType string) ScalarDef {
+	return ScalarDef{
+		Name:        name,
+		GoType:      goType,
+		GoImport:    goImport,
+		GoUnmarshal: "unmarshal" + name,
+		GoMarshal:   "marshal" + name,
+		SwiftType:   swiftType,
+		SwiftDecode: "decode" + name,
+		SwiftEncode: "encode" + name,
+	}
+}
+
+// IsGraphQLScalar reports whether the named scalar is one of the scalars
+// defined by the GraphQL spec.
+func IsGraphQLScalar(name string) bool {
+	switch name {
+	case "ID", "Int", "Float", "String", "Boolean":
+		return true
+	}
+	return false
 }
 
 func New(s *graphql.Schema) Definition {
@@ -63,6 +165,10 @@ func New(s *graphql.Schema) Definition {
 		fn := NewFunction(v)
 		def.Mutations = append(def.Mutations, fn)
 	}
+	for _, v := range s.Subscriptions {
+		fn := NewFunction(v)
+		def.Subscriptions = append(def.Subscriptions, fn)
+	}
 	for _, v := range s.Scalars {
 		tp := NewType(v)
 		def.Scalars = append(def.Scalars, tp)
@@ -94,16 +200,30 @@ func NewFunction(in *graphql.Field) FuncDef {
 	out := FuncDef{}
 	out.Name = in.Name
 	out.Return = NewType(in.Type)
-	for _, arg := range in.Args {
-		out.Arguments = append(out.Arguments, ArgDef{
-			Name: arg.Name.Name,
-			Type: NewType(arg.Type),
+	out.Arguments = NewArguments(in.Args)
+	return out
+}
+
+func NewArguments(in common.InputValueList) ArgDefs {
+	var out ArgDefs
+	for _, arg := range in {
+		out = append(out, ArgDef{
+			Name:       arg.Name.Name,
+			Type:       NewType(arg.Type),
+			HasDefault: arg.Default != nil,
 		})
 	}
 	return out
 }
 
+// NewType returns a TypeDef for the given type. Fields of objects, interfaces
+// and inputs are included, while the types those fields reference are only
+// described by name and kind so recursive types terminate.
 func NewType(in common.Type) TypeDef {
+	return newType(in, true)
+}
+
+func newType(in common.Type, expand bool) TypeDef {
 	out := TypeDef{}
 	out.IsOptional = true
 
@@ -113,20 +233,27 @@ func NewType(in common.Type) TypeDef {
 		out.IsScalar = true
 	case *graphql.Object:
 		out.Name = t.Name
-		out.Fields = NewFields(t.Fields)
+		out.IsConnection = strings.HasSuffix(t.Name, connectionSuffix) && t.Fields.Get("edges") != nil
 		out.Interfaces = NewInterfaces(t.Interfaces)
+		if expand {
+			out.Fields = NewFields(t.Fields)
+		}
 	case *graphql.Interface:
 		out.Name = t.Name
-		out.Fields = NewFields(t.Fields)
+		out.Interfaces = NewInterfaces(t.Interfaces)
 		out.IsInterface = true
+		if expand {
+			out.Fields = NewFields(t.Fields)
+		}
 		for _, v := range t.PossibleTypes {
-			obj := NewType(v)
+			obj := newType(v, false)
 			out.PossibleTypes = append(out.PossibleTypes, obj)
 		}
 	case *graphql.Union:
 		out.Name = t.Name
+		out.IsUnion = true
 		for _, v := range t.PossibleTypes {
-			obj := NewType(v)
+			obj := newType(v, false)
 			out.PossibleTypes = append(out.PossibleTypes, obj)
 		}
 	case *graphql.Enum:
@@ -137,12 +264,17 @@ func NewType(in common.Type) TypeDef {
 		}
 	case *graphql.InputObject:
 		out.Name = t.Name
-		out.Fields = NewFieldInputs(t.Values)
+		if expand {
+			out.Fields = NewFieldInputs(t.Values)
+		}
 	case *common.List:
-		out.Name = t.OfType.String()
+		elem := newType(t.OfType, expand)
+		out = elem
 		out.IsList = true
+		out.IsOptional = true
+		out.OfType = &elem
 	case *common.NonNull:
-		out = NewType(t.OfType)
+		out = newType(t.OfType, expand)
 		out.IsOptional = false
 	default:
 		fmt.Printf("> %#v\n", t)
@@ -163,7 +295,8 @@ func NewFields(in graphql.FieldList) []FieldDef {
 func NewField(in graphql.Field) FieldDef {
 	out := FieldDef{}
 	out.Name = in.Name
-	out.Type = NewType(in.Type)
+	out.Arguments = NewArguments(in.Args)
+	out.Type = newType(in.Type, false)
 	return out
 }
 
@@ -179,7 +312,7 @@ func NewFieldInputs(in common.InputValueList) []FieldDef {
 func NewFieldInput(in common.InputValue) FieldDef {
 	out := FieldDef{}
 	out.Name = in.Name.Name
-	out.Type = NewType(in.Type)
+	out.Type = newType(in.Type, false)
 	return out
 }
 
@@ -207,11 +340,65 @@ func NewInterfaces(in []*graphql.Interface) []string {
 	return out
 }
 
+// Object returns the object with the given name.
+func (v Definition) Object(name string) (TypeDef, bool) {
+	for _, obj := range v.Objects {
+		if obj.Name == name {
+			return obj, true
+		}
+	}
+	return TypeDef{}, false
+}
+
+// Connections returns every Relay style connection in the Definition.
+func (v Definition) Connections() []ConnectionDef {
+	var out []ConnectionDef
+	for _, obj := range v.Objects {
+		if !obj.IsConnection {
+			continue
+		}
+		edges, ok := obj.Field("edges")
+		if !ok {
+			continue
+		}
+		edge, ok := v.Object(edges.Type.Name)
+		if !ok {
+			continue
+		}
+		node, ok := edge.Field("node")
+		if !ok {
+			continue
+		}
+		out = append(out, ConnectionDef{Type: obj, Edge: edge, Node: node.Type})
+	}
+	return out
+}
+
+// Connection returns the connection for a given connection type name.
+func (v Definition) Connection(name string) (ConnectionDef, bool) {
+	for _, conn := range v.Connections() {
+		if conn.Type.Name == name {
+			return conn, true
+		}
+	}
+	return ConnectionDef{}, false
+}
+
+// Field returns the field with the given name.
+func (v TypeDef) Field(name string) (FieldDef, bool) {
+	for _, f := range v.Fields {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return FieldDef{}, false
+}
+
 // Strings
 
 func (v Definition) String() string {
 
-	// Queries & Mutations
+	// Queries, Mutations & Subscriptions
 	out := "type Query {\n"
 	for _, query := range v.Queries {
 		out = out + fmt.Sprintf("\t%s\n", query.String())
@@ -221,6 +408,13 @@ func (v Definition) String() string {
 		out = out + fmt.Sprintf("\t%s\n", mutation.String())
 	}
 	out = out + "}\n\n"
+	if len(v.Subscriptions) > 0 {
+		out = out + "type Subscription {\n"
+		for _, subscription := range v.Subscriptions {
+			out = out + fmt.Sprintf("\t%s\n", subscription.String())
+		}
+		out = out + "}\n\n"
+	}
 
 	// Scalars
 	for _, v := range v.Scalars {
@@ -249,13 +443,13 @@ func (v Definition) String() string {
 
 	// Unions
 	for _, v := range v.Unions {
-		out += printType("union", v) + "\n\n"
+		out += printUnion(v) + "\n\n"
 	}
 	return out
 }
 
 func printType(prefix string, in TypeDef) string {
-	out := fmt.Sprintf("%s %s {\n", prefix, in.Name)
+	out := fmt.Sprintf("%s %s%s {\n", prefix, in.Name, printImplements(in))
 	for _, f := range in.Fields {
 		out = out + fmt.Sprintf("\t%s\n", f.String())
 	}
@@ -263,13 +457,20 @@ func printType(prefix string, in TypeDef) string {
 }
 
 func printInterface(in TypeDef) string {
-	out := fmt.Sprintf("interface %s {\n", in.Name)
+	out := fmt.Sprintf("interface %s%s {\n", in.Name, printImplements(in))
 	for _, f := range in.Fields {
 		out = out + fmt.Sprintf("\t%s\n", f.String())
 	}
 	return out + "}"
 }
 
+func printImplements(in TypeDef) string {
+	if len(in.Interfaces) == 0 {
+		return ""
+	}
+	return " implements " + strings.Join(in.Interfaces, " & ")
+}
+
 func printEnum(in TypeDef) string {
 	out := fmt.Sprintf("enum %s {\n", in.Name)
 	for _, v := range in.EnumValues {
@@ -278,6 +479,14 @@ func printEnum(in TypeDef) string {
 	return out + "}"
 }
 
+func printUnion(in TypeDef) string {
+	var names []string
+	for _, v := range in.PossibleTypes {
+		names = append(names, v.Name)
+	}
+	return fmt.Sprintf("union %s = %s", in.Name, strings.Join(names, " | "))
+}
+
 func (v FuncDef) String() string {
 	return fmt.Sprintf("%s(%s): %s", v.Name, v.Arguments.String(), v.Return.String())
 }
@@ -291,10 +500,14 @@ func (v ArgDefs) String() string {
 }
 
 func (v TypeDef) String() string {
+	name := v.Name
+	if v.IsList && v.OfType != nil {
+		name = fmt.Sprintf("[%s]", v.OfType.String())
+	}
 	if v.IsOptional {
-		return fmt.Sprintf("%s", v.Name)
+		return name
 	}
-	return fmt.Sprintf("%s!", v.Name)
+	return fmt.Sprintf("%s!", name)
 }
 
 func (v ArgDef) String() string {
@@ -302,21 +515,20 @@ func (v ArgDef) String() string {
 }
 
 func (v FieldDef) String() string {
+	if len(v.Arguments) > 0 {
+		return fmt.Sprintf("%s(%s): %s", v.Name, v.Arguments.String(), v.Type.String())
+	}
 	return fmt.Sprintf("%s: %s", v.Name, v.Type.String())
 }
 
 // Swift
 
-// JoinArgsForSwift takes a list of ArgDef and returns a concatenated string
-// suitable for Swift function definitions: `name: String, email: String?`
-func JoinArgsForSwift(in []ArgDef) string {
+// JoinArgsForSwiftVars takes a list of ArgDef and returns the arguments of a
+// Swift initializer call: `name: name, email: email`
+func JoinArgsForSwiftVars(in []ArgDef) string {
 	var out []string
 	for _, arg := range in {
-		optional := ""
-		if arg.Type.IsOptional {
-			optional = "?"
-		}
-		out = append(out, fmt.Sprintf("%s: %s%s", arg.Name, arg.Type.Name, optional))
+		out = append(out, fmt.Sprintf("%s: %s", arg.Name, arg.Name))
 	}
 	return strings.Join(out, ", ")
 }
@@ -345,14 +557,23 @@ func ExcludeSwiftScalars(defs []TypeDef) []TypeDef {
 	return out
 }
 
+// GraphQLScalarToSwiftScalar returns the Swift type a GraphQL scalar is
+// aliased to. Custom scalars are wrapped by a Swift type of the same name.
 func GraphQLScalarToSwiftScalar(in TypeDef) string {
 	if !in.IsScalar {
 		return in.Name
 	}
-	return map[string]string{
-		"ID":        "String",
-		"Timestamp": "String",
-	}[in.Name]
+	convert := map[string]string{
+		"ID":      "String",
+		"Int":     "Int",
+		"Float":   "Double",
+		"String":  "String",
+		"Boolean": "Bool",
+	}
+	if scalar, ok := convert[in.Name]; ok {
+		return scalar
+	}
+	return in.Name
 }
 
 func ToSwiftScalar(in string) string {
@@ -367,8 +588,13 @@ func ToSwiftScalar(in string) string {
 
 // Go
 
+// ToGoScalar returns the Go type for a GraphQL scalar. Custom scalars are
+// wrapped by a Go type of the same name.
 func ToGoScalar(in string) string {
 	convert := map[string]string{
+		"ID":      "graphql.ID",
+		"Int":     "int32",
+		"Float":   "float64",
 		"Boolean": "bool",
 		"String":  "string",
 	}
@@ -378,6 +604,107 @@ func ToGoScalar(in string) string {
 	return in
 }
 
+// Kotlin
+
+// JoinArgsForKotlin takes a list of ArgDef and returns a concatenated string
+// suitable for Kotlin function definitions: `name: String, email: String? = null`
+func JoinArgsForKotlin(in []ArgDef) string {
+	var out []string
+	for _, arg := range in {
+		optional := ""
+		if arg.Type.IsOptional {
+			optional = "? = null"
+		}
+		out = append(out, fmt.Sprintf("%s: %s%s", arg.Name, ToKotlinType(arg.Type), optional))
+	}
+	return strings.Join(out, ", ")
+}
+
+// JoinArgsForKotlinVars takes a list of ArgDef and returns the entries of a
+// Kotlin map of GraphQL variables: `"name" to name, "email" to email`
+func JoinArgsForKotlinVars(in []ArgDef) string {
+	var out []string
+	for _, arg := range in {
+		out = append(out, fmt.Sprintf("%q to %s", arg.Name, arg.Name))
+	}
+	return strings.Join(out, ", ")
+}
+
+// ToKotlinType returns the Kotlin type for a GraphQL type. Lists become a List
+// of their element type, optionality of the type itself is left to callers.
+func ToKotlinType(in TypeDef) string {
+	if in.IsList && in.OfType != nil {
+		elem := ToKotlinType(*in.OfType)
+		if in.OfType.IsOptional {
+			elem += "?"
+		}
+		return fmt.Sprintf("List<%s>", elem)
+	}
+	return ToKotlinScalar(in.Name)
+}
+
+// ToKotlinScalar returns the Kotlin type for a GraphQL scalar. Custom scalars
+// are type aliases of the same name.
+func ToKotlinScalar(in string) string {
+	convert := map[string]string{
+		"ID":      "String",
+		"Int":     "Int",
+		"Float":   "Double",
+		"Boolean": "Boolean",
+		"String":  "String",
+	}
+	if scalar, ok := convert[in]; ok {
+		return scalar
+	}
+	return in
+}
+
+// TypeScript
+
+// JoinArgsForTypeScript takes a list of ArgDef and returns the members of a
+// TypeScript object type: `name: string; email?: string | null`
+func JoinArgsForTypeScript(in []ArgDef) string {
+	var out []string
+	for _, arg := range in {
+		if arg.Type.IsOptional {
+			out = append(out, fmt.Sprintf("%s?: %s | null", arg.Name, ToTypeScriptType(arg.Type)))
+			continue
+		}
+		out = append(out, fmt.Sprintf("%s: %s", arg.Name, ToTypeScriptType(arg.Type)))
+	}
+	return strings.Join(out, "; ")
+}
+
+// ToTypeScriptType returns the TypeScript type for a GraphQL type. Lists
+// become arrays of their element type, optionality of the type itself is left
+// to callers.
+func ToTypeScriptType(in TypeDef) string {
+	if in.IsList && in.OfType != nil {
+		elem := ToTypeScriptType(*in.OfType)
+		if in.OfType.IsOptional {
+			return fmt.Sprintf("(%s | null)[]", elem)
+		}
+		return elem + "[]"
+	}
+	return ToTypeScriptScalar(in.Name)
+}
+
+// ToTypeScriptScalar returns the TypeScript type for a GraphQL scalar. Custom
+// scalars are type aliases of the same name.
+func ToTypeScriptScalar(in string) string {
+	convert := map[string]string{
+		"ID":      "string",
+		"Int":     "number",
+		"Float":   "number",
+		"Boolean": "boolean",
+		"String":  "string",
+	}
+	if scalar, ok := convert[in]; ok {
+		return scalar
+	}
+	return in
+}
+
 // GraphQL
 
 func JoinArgsForGraphQL(in ArgDefs) string {
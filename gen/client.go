@@ -0,0 +1,296 @@
+package gen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/nathanborror/startapp/def"
+)
+
+// clientSelectionDepth is the number of nested objects selected by the
+// documents of the Go client.
+const clientSelectionDepth = 3
+
+// clientWriter writes the Go client package, it looks up the fields of the
+// types referenced by operations to build their selection sets.
+type clientWriter struct {
+	def     def.Definition
+	objects map[string]def.TypeDef
+}
+
+// WriteClient writes the types of the schema and a method for every query and
+// mutation of the Go client package. Documents select every field of the
+// returned types up to clientSelectionDepth nested objects.
+func (f *File) WriteClient(d def.Definition, scalars []def.ScalarDef) {
+	w := clientWriter{def: d, objects: make(map[string]def.TypeDef)}
+	for _, t := range d.Objects {
+		w.objects[t.Name] = t
+	}
+
+	var body File
+	w.writeScalars(&body, scalars)
+	w.writeEnums(&body)
+	w.writeObjects(&body)
+	w.writeAbstractTypes(&body)
+	w.writeInputs(&body)
+	w.writeOperations(&body, "query", d.Queries)
+	w.writeOperations(&body, "mutation", d.Mutations)
+	w.writeOperationTable(&body)
+
+	f.printf("%s\n", doNotEditHeader)
+	f.printf("package client")
+	imports := []string{`"context"`, `"encoding/json"`}
+	if strings.Contains(body.buf.String(), "fmt.") {
+		imports = append(imports, `"fmt"`)
+	}
+	sort.Strings(imports)
+	f.printf("import (\n%s\n)", strings.Join(imports, "\n"))
+	f.buf.Write(body.buf.Bytes())
+}
+
+func (w clientWriter) writeScalars(f *File, scalars []def.ScalarDef) {
+	for _, s := range scalars {
+		f.printf("// %s is the %s GraphQL scalar in its JSON representation.", s.Name, s.Name)
+		if s.Name == "JSON" {
+			f.printf("type %s = json.RawMessage\n", s.Name)
+			continue
+		}
+		f.printf("type %s = string\n", s.Name)
+	}
+}
+
+func (w clientWriter) writeEnums(f *File) {
+	for _, e := range w.def.Enums {
+		f.printf("// %s is the %s GraphQL enum.", e.Name, e.Name)
+		f.printf("type %s string\n", e.Name)
+		f.printf("const (")
+		for _, v := range e.EnumValues {
+			f.printf("%s %s = \"%s\"", enumConstName(e.Name, v), e.Name, v)
+		}
+		f.printf(")\n")
+	}
+}
+
+func (w clientWriter) writeObjects(f *File) {
+	for _, t := range w.def.Objects {
+		f.printf("// %s is the %s GraphQL type.", t.Name, t.Name)
+		f.printf("type %s struct {", t.Name)
+		for _, field := range t.Fields {
+			f.printf("%s %s `json:\"%s\"`", strings.Title(field.Name), clientGoType(field.Type), field.Name)
+		}
+		f.printf("}\n")
+	}
+}
+
+// writeAbstractTypes writes interfaces and unions as a struct with a field for
+// each possible type, the field matching the value's __typename is set.
+func (w clientWriter) writeAbstractTypes(f *File) {
+	var types []def.TypeDef
+	types = append(types, w.def.Interfaces...)
+	types = append(types, w.def.Unions...)
+	for _, t := range types {
+		kind := "union"
+		if t.IsInterface {
+			kind = "interface"
+		}
+		f.printf("// %s is the %s GraphQL %s, the field of the value's type is set.", t.Name, t.Name, kind)
+		f.printf("type %s struct {", t.Name)
+		f.printf("Typename string `json:\"__typename\"`")
+		for _, pt := range t.PossibleTypes {
+			f.printf("%s *%s `json:\"-\"`", pt.Name, pt.Name)
+		}
+		f.printf("}\n")
+
+		f.printf("func (v *%s) UnmarshalJSON(data []byte) error {", t.Name)
+		f.printf("var head struct {")
+		f.printf("Typename string `json:\"__typename\"`")
+		f.printf("}")
+		f.printf("if err := json.Unmarshal(data, &head); err != nil {")
+		f.printf("return err")
+		f.printf("}")
+		f.printf("v.Typename = head.Typename")
+		f.printf("switch head.Typename {")
+		for _, pt := range t.PossibleTypes {
+			f.printf("case \"%s\":", pt.Name)
+			f.printf("v.%s = new(%s)", pt.Name, pt.Name)
+			f.printf("return json.Unmarshal(data, v.%s)", pt.Name)
+		}
+		f.printf("}")
+		f.printf("return fmt.Errorf(\"unknown %s type: %%q\", head.Typename)", t.Name)
+		f.printf("}\n")
+	}
+}
+
+func (w clientWriter) writeInputs(f *File) {
+	for _, t := range w.def.Inputs {
+		f.printf("// %s is the %s GraphQL input.", t.Name, t.Name)
+		f.printf("type %s struct {", t.Name)
+		for _, field := range t.Fields {
+			f.printf("%s %s `json:\"%s\"`", strings.Title(field.Name), clientGoType(field.Type), clientJSONTag(field.Name, field.Type))
+		}
+		f.printf("}\n")
+	}
+}
+
+func (w clientWriter) writeOperations(f *File, kind string, fns []def.FuncDef) {
+	for _, fn := range fns {
+		name := strings.Title(fn.Name)
+		result := clientGoType(fn.Return)
+
+		document := fmt.Sprintf("%s %s", kind, name)
+		call := fn.Name
+		if len(fn.Arguments) > 0 {
+			var vars, args []string
+			for _, arg := range fn.Arguments {
+				vars = append(vars, fmt.Sprintf("$%s: %s", arg.Name, graphQLTypeString(arg.Type)))
+				args = append(args, fmt.Sprintf("%s: $%s", arg.Name, arg.Name))
+			}
+			document += fmt.Sprintf("(%s)", strings.Join(vars, ", "))
+			call += fmt.Sprintf("(%s)", strings.Join(args, ", "))
+		}
+		if sel := w.selection(fn.Return, 1); sel != "" {
+			call += " " + sel
+		}
+		document += fmt.Sprintf(" { %s }", call)
+		f.printf("const %sDocument = `%s`\n", lowerFirstLetter(name), document)
+
+		params := "ctx context.Context"
+		variables := "nil"
+		if len(fn.Arguments) > 0 {
+			f.printf("// %sArgs are the arguments of the %s %s.", name, fn.Name, kind)
+			f.printf("type %sArgs struct {", name)
+			for _, arg := range fn.Arguments {
+				f.printf("%s %s `json:\"%s\"`", strings.Title(arg.Name), clientGoType(arg.Type), clientJSONTag(arg.Name, arg.Type))
+			}
+			f.printf("}\n")
+			params += fmt.Sprintf(", args %sArgs", name)
+			variables = "args"
+		}
+
+		f.printf("// %s calls the %s %s.", name, fn.Name, kind)
+		f.printf("func (c *Client) %s(%s) (%s, error) {", name, params, result)
+		f.printf("var out struct {")
+		f.printf("%s %s `json:\"%s\"`", name, result, fn.Name)
+		f.printf("}")
+		f.printf("err := c.Do(ctx, %sDocument, %s, &out)", lowerFirstLetter(name), variables)
+		f.printf("return out.%s, err", name)
+		f.printf("}\n")
+	}
+}
+
+// writeOperationTable writes Operations, which calls queries and mutations by
+// name with arguments decoded from JSON for command line tools.
+func (w clientWriter) writeOperationTable(f *File) {
+	f.printf("// Operations calls a query or mutation by name with its arguments decoded")
+	f.printf("// from JSON.")
+	f.printf("var Operations = map[string]func(ctx context.Context, c *Client, args json.RawMessage) (interface{}, error){")
+	var fns []def.FuncDef
+	fns = append(fns, w.def.Queries...)
+	fns = append(fns, w.def.Mutations...)
+	for _, fn := range fns {
+		name := strings.Title(fn.Name)
+		f.printf("\"%s\": func(ctx context.Context, c *Client, data json.RawMessage) (interface{}, error) {", fn.Name)
+		if len(fn.Arguments) == 0 {
+			f.printf("return c.%s(ctx)", name)
+			f.printf("},")
+			continue
+		}
+		f.printf("var args %sArgs", name)
+		f.printf("if err := decodeArgs(data, &args); err != nil {")
+		f.printf("return nil, err")
+		f.printf("}")
+		f.printf("return c.%s(ctx, args)", name)
+		f.printf("},")
+	}
+	f.printf("}\n")
+}
+
+// selection returns the selection set of a type or an empty string for
+// scalars and enums.
+func (w clientWriter) selection(t def.TypeDef, depth int) string {
+	if t.IsList && t.OfType != nil {
+		return w.selection(*t.OfType, depth)
+	}
+	if t.IsScalar || t.IsEnum {
+		return ""
+	}
+	if !t.IsInterface && !t.IsUnion {
+		return w.objectSelection(t.Name, depth)
+	}
+	parts := []string{"__typename"}
+	for _, pt := range w.possibleTypes(t) {
+		parts = append(parts, fmt.Sprintf("... on %s %s", pt.Name, w.objectSelection(pt.Name, depth)))
+	}
+	return fmt.Sprintf("{ %s }", strings.Join(parts, " "))
+}
+
+func (w clientWriter) objectSelection(name string, depth int) string {
+	var parts []string
+	for _, field := range w.objects[name].Fields {
+		if field.Type.IsScalar || field.Type.IsEnum {
+			parts = append(parts, field.Name)
+			continue
+		}
+		if depth >= clientSelectionDepth {
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%s %s", field.Name, w.selection(field.Type, depth+1)))
+	}
+	if len(parts) == 0 {
+		parts = append(parts, "__typename")
+	}
+	return fmt.Sprintf("{ %s }", strings.Join(parts, " "))
+}
+
+// possibleTypes returns the possible types of an interface or union as they
+// are declared in the schema.
+func (w clientWriter) possibleTypes(t def.TypeDef) []def.TypeDef {
+	for _, in := range append(append([]def.TypeDef{}, w.def.Interfaces...), w.def.Unions...) {
+		if in.Name == t.Name {
+			return in.PossibleTypes
+		}
+	}
+	return t.PossibleTypes
+}
+
+// clientGoType returns the Go type of the client for a GraphQL type. Optional
+// values and objects are pointers, objects always are so types can refer to
+// themselves.
+func clientGoType(in def.TypeDef) string {
+	if in.IsList && in.OfType != nil {
+		return "[]" + clientGoType(*in.OfType)
+	}
+	out := in.Name
+	if in.IsScalar {
+		out = def.ToGoScalar(in.Name)
+		if in.Name == "ID" {
+			out = "string"
+		}
+	}
+	if in.IsOptional || !(in.IsScalar || in.IsEnum) {
+		return "*" + out
+	}
+	return out
+}
+
+// clientJSONTag returns the JSON tag of an argument or input field, optional
+// values are omitted when they're nil.
+func clientJSONTag(name string, t def.TypeDef) string {
+	if t.IsOptional {
+		return name + ",omitempty"
+	}
+	return name
+}
+
+// graphQLTypeString returns the GraphQL notation of a type e.g. [String!]!
+func graphQLTypeString(in def.TypeDef) string {
+	out := in.Name
+	if in.IsList && in.OfType != nil {
+		out = fmt.Sprintf("[%s]", graphQLTypeString(*in.OfType))
+	}
+	if !in.IsOptional {
+		out += "!"
+	}
+	return out
+}
@@ -0,0 +1,144 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines shown around each change.
+const diffContext = 3
+
+// diffOp is a single line of a diff: ' ' kept, '-' removed or '+' added.
+type diffOp struct {
+	kind byte
+	line string
+	a, b int // Line index in the old and new file before this op
+}
+
+// unifiedDiff returns a unified diff between the existing content of filename
+// and data. Missing and removed files are diffed against /dev/null.
+func unifiedDiff(filename string, existing []byte, data []byte) string {
+	ops := diffLines(splitLines(existing), splitLines(data))
+
+	var changes []int
+	for i, op := range ops {
+		if op.kind != ' ' {
+			changes = append(changes, i)
+		}
+	}
+	if len(changes) == 0 {
+		return ""
+	}
+
+	var out bytes.Buffer
+	name := filepath.ToSlash(filename)
+	if existing == nil {
+		fmt.Fprintf(&out, "--- /dev/null\n")
+	} else {
+		fmt.Fprintf(&out, "--- a/%s\n", name)
+	}
+	if data == nil {
+		fmt.Fprintf(&out, "+++ /dev/null\n")
+	} else {
+		fmt.Fprintf(&out, "+++ b/%s\n", name)
+	}
+
+	// Group changes that are close enough to share context into hunks.
+	for i := 0; i < len(changes); {
+		j := i
+		for j+1 < len(changes) && changes[j+1]-changes[j] <= 2*diffContext+1 {
+			j++
+		}
+		start := changes[i] - diffContext
+		if start < 0 {
+			start = 0
+		}
+		end := changes[j] + diffContext + 1
+		if end > len(ops) {
+			end = len(ops)
+		}
+		writeHunk(&out, ops[start:end])
+		i = j + 1
+	}
+	return out.String()
+}
+
+func writeHunk(out *bytes.Buffer, ops []diffOp) {
+	var aCount, bCount int
+	for _, op := range ops {
+		if op.kind != '+' {
+			aCount++
+		}
+		if op.kind != '-' {
+			bCount++
+		}
+	}
+	fmt.Fprintf(out, "@@ -%s +%s @@\n", hunkRange(ops[0].a, aCount), hunkRange(ops[0].b, bCount))
+	for _, op := range ops {
+		out.WriteByte(op.kind)
+		out.WriteString(op.line)
+		if !strings.HasSuffix(op.line, "\n") {
+			out.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
+
+// hunkRange formats the line range of a hunk, empty ranges start at the line
+// before the hunk.
+func hunkRange(start int, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+// diffLines returns the ops that turn a into b using their longest common
+// subsequence of lines.
+func diffLines(a []string, b []string) []diffOp {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var ops []diffOp
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			ops = append(ops, diffOp{' ', a[i], i, j})
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+			ops = append(ops, diffOp{'-', a[i], i, j})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', b[j], i, j})
+			j++
+		}
+	}
+	return ops
+}
+
+func splitLines(data []byte) []string {
+	if len(data) == 0 {
+		return nil
+	}
+	lines := strings.SplitAfter(string(data), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
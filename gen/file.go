@@ -4,10 +4,9 @@ import (
 	"bytes"
 	"fmt"
 	"go/format"
-	"io/ioutil"
 	"log"
-	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/nathanborror/startapp/def"
@@ -15,10 +14,14 @@ import (
 
 // File represents a file object that can written to a file system.
 type File struct {
-	buf  bytes.Buffer
-	name string
-	ext  string
-	err  error
+	buf       bytes.Buffer
+	name      string
+	ext       string
+	regions   map[string]string // Regions kept from the existing file
+	source    string            // Recorded in the manifest
+	overwrite bool              // Replace the existing file even if it's user owned
+	output    *Output
+	err       error
 }
 
 // NewFile returns a new File object.
@@ -36,26 +39,17 @@ func (f *File) Write(elem ...string) {
 		return
 	}
 
-	// Create directory if missing
 	dir := filepath.Join(elem...)
-	if _, err := os.Stat(dir); os.IsNotExist(err) {
-		if err := os.MkdirAll(dir, 0755); err != nil {
-			f.err = err
-			return
-		}
+	if f.output == nil {
+		f.output = NewOutput(dir, WriteMode)
 	}
+	f.err = f.output.writeFile(filepath.Join(dir, f.filename()), f.source, f.buf.Bytes(), f.overwrite)
+}
 
-	// Write file if missing
-	filePath := filepath.Join(dir, f.filename())
-	if _, err := os.Stat(filePath); os.IsNotExist(err) {
-		if err := ioutil.WriteFile(filePath, f.buf.Bytes(), 0644); err != nil {
-			f.err = err
-			return
-		}
-		fmt.Printf("\tCreated File: %s\n", filePath)
-	} else {
-		fmt.Printf("\tFile Already Exists: %s\n", filePath)
-	}
+// ReadRegions reads the regions of the existing file in the given directory
+// so they're kept when the file's regions are printed.
+func (f *File) ReadRegions(elem ...string) {
+	f.regions = readRegions(filepath.Join(filepath.Join(elem...), f.filename()))
 }
 
 // WriteBytes writes the given bytes to the file buffer.
@@ -106,72 +100,1066 @@ func (f *File) printf(format string, args ...interface{}) {
 	fmt.Fprintf(&f.buf, format+"\n", args...)
 }
 
+// printRegion prints a region that keeps its content when the file is
+// generated again. The content written by write is used for new regions.
+func (f *File) printRegion(name string, write func()) {
+	f.printf("// %s %s", regionBegin, name)
+	if content, ok := f.regions[name]; ok {
+		f.buf.WriteString(content)
+	} else {
+		write()
+	}
+	f.printf("// %s %s", regionEnd, name)
+}
+
 // Go
 
 func (f *File) WriteAPIResolver(projectPath string, t def.TypeDef) {
+	var body File
+	body.writeAPIResolver(t)
+	f.printf("%s\n", doNotEditHeader)
 	f.printf("package api")
-	f.printf("import ( graphql \"github.com/neelance/graphql-go\" )")
+	f.printImports(projectPath, body.buf.String())
+	f.buf.Write(body.buf.Bytes())
+}
 
+func (f *File) writeAPIResolver(t def.TypeDef) {
+	if len(t.Interfaces) > 0 {
+		f.printf("// %sResolver implements %s.", lowerFirstLetter(t.Name), strings.Join(t.Interfaces, ", "))
+	}
 	f.printf("type %sResolver struct {", lowerFirstLetter(t.Name))
 	f.printf("%s *state.%s", lowerFirstLetter(t.Name), t.Name)
 	f.printf("*Backends")
 	f.printf("}")
 
 	for _, field := range t.Fields {
-		if field.Type.IsScalar {
-			f.printf("func (r *%sResolver) %s() %s {", lowerFirstLetter(t.Name), strings.Title(field.Name), def.ToGoScalar(field.Type.Name))
-			f.printf("return r.%s.%s", lowerFirstLetter(t.Name), strings.Title(field.Name))
+		switch {
+		case isObjectConnection(t, field):
+			f.printf("func (r *%sResolver) %s {", lowerFirstLetter(t.Name), goFieldSignature(field))
+			f.writeConnectionFetch(field.Type, fmt.Sprintf("Fetch%s%s(r.%s.Id, page)", t.Name, strings.Title(field.Name), lowerFirstLetter(t.Name)))
+			f.printf("}\n")
+		case isResolvable(field.Type):
+			f.printf("func (r *%sResolver) %s {", lowerFirstLetter(t.Name), goFieldSignature(field))
+			f.writeFieldResolver(lowerFirstLetter(t.Name), field)
 			f.printf("}\n")
+		}
+	}
+}
+
+// writeFieldResolver writes the body of a field resolver that converts the
+// value of the state struct's field to the type the resolver returns.
+func (f *File) writeFieldResolver(recv string, field def.FieldDef) {
+	t := field.Type
+	value := fmt.Sprintf("r.%s.%s", recv, strings.Title(field.Name))
+	ret := ""
+	if !t.IsScalar && !t.IsEnum {
+		ret = ", nil"
+	}
+
+	if !t.IsList {
+		switch {
+		case !t.IsOptional:
+			f.printf("return %s%s", goStateToAPI(value, t, false), ret)
+		case isIdentity(t):
+			f.printf("return %s%s", value, ret)
+		default:
+			f.printf("if %s == nil {", value)
+			f.printf("return nil%s", ret)
+			f.printf("}")
+			if isObject(t) {
+				f.printf("return %s%s", goStateToAPI(value, t, true), ret)
+				return
+			}
+			f.printf("v := %s", goStateToAPI("*"+value, t, false))
+			f.printf("return &v%s", ret)
+		}
+		return
+	}
+
+	elem := *t.OfType
+	if !t.IsOptional && !elem.IsOptional && isIdentity(elem) {
+		f.printf("return %s%s", value, ret)
+		return
+	}
+	if t.IsOptional {
+		f.printf("if %s == nil {", value)
+		f.printf("return nil%s", ret)
+		f.printf("}")
+	}
+	list := t
+	list.IsOptional = false
+	f.printf("out := make(%s, len(%s))", goResolverType(list), value)
+	f.printf("for i := range %s {", value)
+	if elem.IsOptional && !isObject(elem) {
+		f.printf("v := %s", goStateToAPI(value+"[i]", elem, false))
+		f.printf("out[i] = &v")
+	} else {
+		f.printf("out[i] = %s", goStateToAPI(value+"[i]", elem, false))
+	}
+	f.printf("}")
+	if t.IsOptional {
+		f.printf("return &out%s", ret)
+	} else {
+		f.printf("return out%s", ret)
+	}
+}
+
+func (f *File) WriteAPIConnectionResolver(projectPath string, conn def.ConnectionDef) {
+	var body File
+	name := lowerFirstLetter(conn.Type.Name)
+	edge := lowerFirstLetter(conn.Edge.Name)
+	node := lowerFirstLetter(conn.Node.Name)
+
+	body.printf("// %sResolver resolves a page of %s edges.", name, conn.Node.Name)
+	body.printf("type %sResolver struct {", name)
+	body.printf("connection *state.%s", conn.Type.Name)
+	body.printf("*Backends")
+	body.printf("}\n")
+
+	for _, field := range conn.Type.Fields {
+		if !connectionFields[field.Name] {
 			continue
 		}
-		if field.Type.IsEnum {
-			f.printf("// Implement Enum: %s\n", field.Name)
+		body.printf("func (r *%sResolver) %s {", name, goFieldSignature(field))
+		switch field.Name {
+		case "edges":
+			body.printf("edges := make([]*%sResolver, len(r.connection.Results))", edge)
+			body.printf("for i := range r.connection.Results {")
+			body.printf("edges[i] = &%sResolver{%s: &r.connection.Results[i], Backends: r.Backends}", edge, node)
+			body.printf("}")
+			if field.Type.IsOptional {
+				body.printf("return &edges, nil")
+			} else {
+				body.printf("return edges, nil")
+			}
+		case "pageInfo":
+			body.printf("return encodePageInfo(r.connection.PageInfo), nil")
+		case "total", "totalCount":
+			body.printf("return int32(r.connection.Total)")
+		}
+		body.printf("}\n")
+	}
+
+	body.printf("// %sResolver resolves an edge of %s.", edge, conn.Type.Name)
+	body.printf("type %sResolver struct {", edge)
+	body.printf("%s *state.%s", node, conn.Node.Name)
+	body.printf("*Backends")
+	body.printf("}\n")
+
+	for _, field := range conn.Edge.Fields {
+		if !edgeFields[field.Name] {
 			continue
 		}
-		if field.Type.IsInterface {
-			f.printf("// Implement Interface: %s\n", field.Name)
+		body.printf("func (r *%sResolver) %s {", edge, goFieldSignature(field))
+		switch field.Name {
+		case "node":
+			body.printf("return &%sResolver{%s: r.%s, Backends: r.Backends}, nil", node, node, node)
+		case "cursor":
+			body.printf("return encodeCursor(r.%s.Cursor)", node)
+		}
+		body.printf("}\n")
+	}
+
+	f.printf("%s\n", doNotEditHeader)
+	f.printf("package api")
+	f.printImports(projectPath, body.buf.String())
+	f.buf.Write(body.buf.Bytes())
+}
+
+func (f *File) WriteStateConnections(connections []def.ConnectionDef, fields []objectConnection) {
+	f.printf("%s\n", doNotEditHeader)
+	f.printf("package state")
+
+	f.printf("// ConnectionStater is the interface that wraps paginated Connection I/O.")
+	f.printf("// The connection fields of objects page through the records connected to")
+	f.printf("// the object by edges of the field's kind.")
+	f.printf("type ConnectionStater interface {")
+	for _, conn := range connections {
+		f.printf("Fetch%s(page Page) (*%s, error)", conn.Type.Name, conn.Type.Name)
+	}
+	for _, oc := range fields {
+		f.printf("Fetch%s(id string, page Page) (*%s, error)", oc.name(), oc.conn.Type.Name)
+		f.printf("Connect%s(id string, nodeID string) error", oc.name())
+		f.printf("Disconnect%s(id string, nodeID string) error", oc.name())
+	}
+	f.printf("}\n")
+
+	if len(fields) > 0 {
+		f.printf("// Edge kinds of the connection fields of objects.")
+		f.printf("const (")
+		for _, oc := range fields {
+			f.printf("%sEdgeKind = \"%s\"", oc.name(), oc.edgeKind())
+		}
+		f.printf(")\n")
+	}
+
+	for _, conn := range connections {
+		f.printf("// %s represents a page of %s records.", conn.Type.Name, conn.Node.Name)
+		f.printf("type %s struct {", conn.Type.Name)
+		f.printf("Results []%s", conn.Node.Name)
+		f.printf("PageInfo")
+		f.printf("}\n")
+	}
+}
+
+func (f *File) WritePostgresConnections(projectPath string, connections []def.ConnectionDef, fields []objectConnection) {
+	var body File
+	for _, conn := range connections {
+		body.printf("func (m *manager) Fetch%s(page state.Page) (*state.%s, error) {", conn.Type.Name, conn.Type.Name)
+		body.printf("res := ledger.Fetch(state.%sDataType, ledger.Page(page), ledger.Options{DB: m.db.DB})", conn.Node.Name)
+		body.writeFetchResult(conn)
+		body.printf("}\n")
+	}
+	for _, oc := range fields {
+		edge := func(nodeID string) string {
+			return fmt.Sprintf("ledger.Edge{FromID: id, ToID: %s, Kind: state.%sEdgeKind}", nodeID, oc.name())
+		}
+		body.printf("func (m *manager) Fetch%s(id string, page state.Page) (*state.%s, error) {", oc.name(), oc.conn.Type.Name)
+		body.printf("edge := %s", edge(`""`))
+		body.printf("res := ledger.Fetch(state.%sDataType, ledger.Page(page), ledger.Options{DB: m.db.DB, Edge: &edge})", oc.conn.Node.Name)
+		body.writeFetchResult(oc.conn)
+		body.printf("}\n")
+
+		body.printf("func (m *manager) Connect%s(id string, nodeID string) error {", oc.name())
+		body.printf("return wrapErr(ledger.Connect(%s, ledger.Options{DB: m.db.DB}))", edge("nodeID"))
+		body.printf("}\n")
+
+		body.printf("func (m *manager) Disconnect%s(id string, nodeID string) error {", oc.name())
+		body.printf("return wrapErr(ledger.Disconnect(%s, ledger.Options{DB: m.db.DB}))", edge("nodeID"))
+		body.printf("}\n")
+	}
+	f.printf("%s\n", doNotEditHeader)
+	f.printf("package postgres")
+	f.printImports(projectPath, body.buf.String())
+	f.buf.Write(body.buf.Bytes())
+}
+
+// writeFetchResult writes the rest of a postgres fetch method that converts
+// the ledger Result res to a page of a connection.
+func (f *File) writeFetchResult(conn def.ConnectionDef) {
+	f.printf("if err := res.Err(); err != nil {")
+	f.printf("return nil, wrapErr(err)")
+	f.printf("}")
+	f.printf("out := state.%s{", conn.Type.Name)
+	f.printf("PageInfo: state.PageInfo{")
+	f.printf("Total: res.Total,")
+	f.printf("HasNext: res.HasNext,")
+	f.printf("HasPrevious: res.HasPrevious,")
+	f.printf("StartID: res.StartID,")
+	f.printf("EndID: res.EndID,")
+	f.printf("StartCursor: res.StartCursor,")
+	f.printf("EndCursor: res.EndCursor,")
+	f.printf("},")
+	f.printf("}")
+	f.printf("for res.Next() {")
+	f.printf("var v state.%s", conn.Node.Name)
+	f.printf("res.Scan(&v)")
+	f.printf("out.Results = append(out.Results, v)")
+	f.printf("}")
+	f.printf("return &out, wrapErr(res.Err())")
+}
+
+func (f *File) WriteStateObjects(namespace string, objects []def.TypeDef, scalars []def.ScalarDef) {
+	var body File
+	types := make(map[string]def.ScalarDef)
+	for _, s := range scalars {
+		types[s.Name] = s
+	}
+	nodes := nodeObjects(objects)
+
+	body.printf("// ObjectStater is the interface that wraps reads of the Node types")
+	body.printf("// generated from the schema.")
+	body.printf("type ObjectStater interface {")
+	for _, t := range nodes {
+		body.printf("Read%s(id string) (*%s, error)", t.Name, t.Name)
+	}
+	body.printf("}\n")
+
+	if len(nodes) > 0 {
+		body.printf("const (")
+		for _, t := range nodes {
+			body.printf("%sDataType = \"%s.%s\"", t.Name, namespace, strings.ToLower(t.Name))
+		}
+		body.printf(")\n")
+	}
+
+	imports := make(map[string]bool)
+	for _, t := range objects {
+		isNode := implements(t, "Node")
+		body.printf("// %s represents a %s object.", t.Name, t.Name)
+		body.printf("type %s struct {", t.Name)
+		body.writeStateFields(t, isNode, types, imports)
+		if isNode {
+			body.printf("Node")
+		}
+		body.printf("}\n")
+	}
+
+	for _, t := range nodes {
+		body.printf("func (i *%s) IdentifyType() string { return %sDataType }", t.Name, t.Name)
+	}
+
+	f.printf("%s\n", doNotEditHeader)
+	f.printf("package state")
+	f.printStateImports(imports)
+	f.buf.Write(body.buf.Bytes())
+}
+
+// accountStateFields are the fields of state.Account the state templates
+// rely on. They're added to the Account type of the schema when it doesn't
+// declare them, Password is never part of the schema.
+var accountStateFields = []struct {
+	name   string
+	goType string
+}{
+	{"Name", "string"},
+	{"Email", "string"},
+	{"Password", "string"},
+	{"IsActive", "bool"},
+}
+
+// WriteStateAccount writes the Account type used by AccountStater. It has the
+// fields of the schema's Account type and the fields the templates need.
+func (f *File) WriteStateAccount(namespace string, t def.TypeDef, scalars []def.ScalarDef) {
+	var body File
+	types := make(map[string]def.ScalarDef)
+	for _, s := range scalars {
+		types[s.Name] = s
+	}
+	imports := make(map[string]bool)
+
+	body.printf("const AccountDataType = \"%s.account\"\n", namespace)
+	body.printf("// Account represents an Account object.")
+	body.printf("type Account struct {")
+	written := body.writeStateFields(t, true, types, imports)
+	for _, field := range accountStateFields {
+		if !written[field.name] {
+			body.printf("%s %s", field.name, field.goType)
+		}
+	}
+	body.printf("Node")
+	body.printf("}\n")
+	body.printf("func (i *Account) IdentifyType() string { return AccountDataType }")
+
+	f.printf("%s\n", doNotEditHeader)
+	f.printf("package state")
+	f.printStateImports(imports)
+	f.buf.Write(body.buf.Bytes())
+}
+
+// writeStateFields writes the state fields of an object and returns their
+// names. The fields provided by Node are left out when isNode is true.
+func (f *File) writeStateFields(t def.TypeDef, isNode bool, types map[string]def.ScalarDef, imports map[string]bool) map[string]bool {
+	written := make(map[string]bool)
+	for _, field := range t.Fields {
+		if !isStateField(field.Type) || isNode && nodeFields[field.Name] {
 			continue
 		}
-		f.printf("func (r *%sResolver) %s() (%s*%sResolver, error) {", lowerFirstLetter(t.Name), strings.Title(field.Name), isList(field.Type), lowerFirstLetter(field.Type.Name))
-		f.printf("return nil, fmt.Errorf(\"Not Implemented\")")
+		if s, ok := types[field.Type.Name]; ok && s.GoImport != "" {
+			imports[s.GoImport] = true
+		}
+		name := strings.Title(field.Name)
+		f.printf("%s %s", name, goStateType(field.Type, types))
+		written[name] = true
+	}
+	return written
+}
+
+func (f *File) printStateImports(imports map[string]bool) {
+	if len(imports) == 0 {
+		return
+	}
+	var paths []string
+	for path := range imports {
+		paths = append(paths, fmt.Sprintf("%q", path))
+	}
+	sort.Strings(paths)
+	f.printf("import (\n%s\n)", strings.Join(paths, "\n"))
+}
+
+func (f *File) WritePostgresObjects(projectPath string, objects []def.TypeDef) {
+	var body File
+	for _, t := range nodeObjects(objects) {
+		body.printf("func (m *manager) Read%s(id string) (*state.%s, error) {", t.Name, t.Name)
+		body.printf("var out state.%s", t.Name)
+		body.printf("rec := ledger.NewRecord(&state.%s{Node: state.Node{Id: id}}, ledger.Options{DB: m.db.DB})", t.Name)
+		body.printf("rec.Read()")
+		body.printf("rec.Scan(&out)")
+		body.printf("return &out, wrapErr(rec.Err())")
+		body.printf("}\n")
+	}
+	f.printf("%s\n", doNotEditHeader)
+	f.printf("package postgres")
+	f.printImports(projectPath, body.buf.String())
+	f.buf.Write(body.buf.Bytes())
+}
+
+func (f *File) WriteAPIInterfaceResolver(projectPath string, t def.TypeDef) {
+	var body File
+	name := lowerFirstLetter(t.Name)
+
+	body.printf("// %s is implemented by the resolvers of every type that implements", name)
+	body.printf("// %s.", t.Name)
+	body.printf("type %s interface {", name)
+	for _, field := range t.Fields {
+		body.printf("%s", goFieldSignature(field))
+	}
+	body.printf("}\n")
+
+	body.printf("// %sResolver resolves the %s interface. The result is one of: %s.", name, t.Name, joinTypeNames(t.PossibleTypes))
+	body.printf("type %sResolver struct {", name)
+	body.printf("%s", name)
+	body.printf("}\n")
+
+	for _, tp := range t.PossibleTypes {
+		body.printf("func (r *%sResolver) To%s() (*%sResolver, bool) {", name, tp.Name, lowerFirstLetter(tp.Name))
+		body.printf("res, ok := r.%s.(*%sResolver)", name, lowerFirstLetter(tp.Name))
+		body.printf("return res, ok")
+		body.printf("}\n")
+	}
+
+	f.printf("%s\n", doNotEditHeader)
+	f.printf("package api")
+	f.printImports(projectPath, body.buf.String())
+	f.buf.Write(body.buf.Bytes())
+}
+
+func (f *File) WriteAPIUnionResolver(t def.TypeDef) {
+	f.printf("%s\n", doNotEditHeader)
+	f.printf("package api")
+
+	f.printf("// %sResolver resolves the %s union. The result is one of: %s.", lowerFirstLetter(t.Name), t.Name, joinTypeNames(t.PossibleTypes))
+	f.printf("type %sResolver struct {", lowerFirstLetter(t.Name))
+	f.printf("result interface{}")
+	f.printf("}\n")
+
+	for _, tp := range t.PossibleTypes {
+		f.printf("func (r *%sResolver) To%s() (*%sResolver, bool) {", lowerFirstLetter(t.Name), tp.Name, lowerFirstLetter(tp.Name))
+		f.printf("res, ok := r.result.(*%sResolver)", lowerFirstLetter(tp.Name))
+		f.printf("return res, ok")
 		f.printf("}\n")
 	}
 }
 
-func (f *File) WriteAPIQueries(projectPath string) {
+func (f *File) WriteAPIInputs(projectPath string, inputs []def.TypeDef) {
+	var body File
+	for _, t := range inputs {
+		body.printf("// %s is the %s GraphQL input.", t.Name, t.Name)
+		body.printf("type %s struct {", t.Name)
+		for _, field := range t.Fields {
+			body.printf("%s %s", strings.Title(field.Name), goInputType(field.Type))
+		}
+		body.printf("}\n")
+	}
+	f.printf("%s\n", doNotEditHeader)
 	f.printf("package api")
-	f.printf("import \"%s/%s\"", projectPath, "state")
+	f.printImports(projectPath, body.buf.String())
+	f.buf.Write(body.buf.Bytes())
 }
 
-func (f *File) WriteAPIMutations(projectPath string) {
+// WriteAPIRootResolvers writes the root resolvers that are generated from the
+// schema: connections and Relay style node lookups.
+func (f *File) WriteAPIRootResolvers(projectPath string, fns []def.FuncDef) {
+	var body File
+	for _, fn := range fns {
+		returnType := goResolverType(fn.Return)
+		switch {
+		case isNodeFunc(fn):
+			body.printf("func (r *rootResolver) %s(ctx context.Context%s) (%s, error) {", strings.Title(fn.Name), goArgs(fn.Arguments), returnType)
+			body.writeNodeDispatch(fn)
+		case isConnectionFunc(fn):
+			body.printf("func (r *rootResolver) %s(ctx context.Context, args connectionArgs) (%s, error) {", strings.Title(fn.Name), returnType)
+			body.writeConnectionFetch(fn.Return, fmt.Sprintf("Fetch%s(page)", fn.Return.Name))
+		default:
+			continue
+		}
+		body.printf("}\n")
+	}
+	f.printf("%s\n", doNotEditHeader)
 	f.printf("package api")
-	f.printf("import \"%s/%s\"", projectPath, "state")
+	f.printImports(projectPath, body.buf.String())
+	f.buf.Write(body.buf.Bytes())
 }
 
-func (f *File) WriteScalars(scalars []def.TypeDef) {
+// WriteAPIRootResolverStubs writes the root resolvers that aren't generated.
+// Subscriptions return a channel of results rather than a single result.
+func (f *File) WriteAPIRootResolverStubs(projectPath string, fns []def.FuncDef, isSubscription bool) {
+	body := File{regions: f.regions}
+	for _, fn := range fns {
+		if !isSubscription && isGeneratedFunc(fn) {
+			continue
+		}
+		returnType := goResolverType(fn.Return)
+		zero := goZeroValue(returnType)
+		if isSubscription {
+			body.printf("// %s streams '%s' events to subscribers. Close the channel once", strings.Title(fn.Name), fn.Name)
+			body.printf("// ctx is done.")
+			body.printf("func (r *rootResolver) %s(ctx context.Context%s) (<-chan %s, error) {", strings.Title(fn.Name), goArgs(fn.Arguments), returnType)
+			zero = "nil"
+		} else {
+			body.printf("func (r *rootResolver) %s(ctx context.Context%s) (%s, error) {", strings.Title(fn.Name), goArgs(fn.Arguments), returnType)
+		}
+		body.printRegion("rootResolver."+strings.Title(fn.Name), func() {
+			body.printf("return %s, fmt.Errorf(\"Not Implemented\")", zero)
+		})
+		body.printf("}\n")
+	}
+	f.writeStubs(projectPath, body)
+}
+
+// WriteAPIFieldStubs writes the field resolvers that aren't generated, such as
+// fields returning interfaces, unions or connections.
+func (f *File) WriteAPIFieldStubs(projectPath string, stubs []fieldStub) {
+	body := File{regions: f.regions}
+	for _, stub := range stubs {
+		body.printf("func (r *%s) %s {", stub.resolver, goFieldSignature(stub.field))
+		body.printRegion(stub.resolver+"."+strings.Title(stub.field.Name), func() {
+			body.writeNotImplemented(stub.field)
+		})
+		body.printf("}\n")
+	}
+	f.writeStubs(projectPath, body)
+}
+
+// writeStubs writes a file of resolvers whose bodies are implemented by the
+// user. Packages used by the resolvers are imported automatically, others
+// are imported in the imports region.
+func (f *File) writeStubs(projectPath string, body File) {
+	f.printf("%s\n", regionsHeader)
 	f.printf("package api")
+	f.printImports(projectPath, body.buf.String()+f.regions["imports"])
+	f.printRegion("imports", func() {})
+	f.printf("")
+	f.buf.Write(body.buf.Bytes())
+}
+
+// writeConnectionFetch writes the body of a resolver that returns a page of
+// the connection t by calling the state method fetch, which uses page.
+func (f *File) writeConnectionFetch(t def.TypeDef, fetch string) {
+	f.printf("page, err := decodePage(args)")
+	f.printf("if err != nil {")
+	f.printf("return nil, err")
+	f.printf("}")
+	f.printf("conn, err := r.State.%s", fetch)
+	f.printf("if err != nil {")
+	f.printf("return nil, err")
+	f.printf("}")
+	f.printf("return &%sResolver{connection: conn, Backends: r.Backends}, nil", lowerFirstLetter(t.Name))
+}
+
+// writeNodeDispatch writes the body of a Relay style node resolver. The
+// record's datatype is read from the index and used to pick the state reader
+// for the matching type.
+func (f *File) writeNodeDispatch(fn def.FuncDef) {
+	name := lowerFirstLetter(fn.Return.Name)
+	f.printf("id := decodeID(args.Id)")
+	f.printf("datatype, err := r.State.ReadNodeType(id)")
+	f.printf("if err != nil {")
+	f.printf("return nil, err")
+	f.printf("}")
+	f.printf("switch datatype {")
+	for _, tp := range fn.Return.PossibleTypes {
+		f.printf("case state.%sDataType:", tp.Name)
+		f.printf("v, err := r.State.Read%s(id)", tp.Name)
+		f.printf("if err != nil {")
+		f.printf("return nil, err")
+		f.printf("}")
+		f.printf("return &%sResolver{&%sResolver{%s: v, Backends: r.Backends}}, nil", name, lowerFirstLetter(tp.Name), lowerFirstLetter(tp.Name))
+	}
+	f.printf("}")
+	f.printf("return nil, fmt.Errorf(\"Unknown %s type: %%s\", datatype)", fn.Return.Name)
+}
+
+// printImports writes an import declaration for the packages referenced in
+// body, which is the rest of the file's source.
+func (f *File) printImports(projectPath string, body string) {
+	var std, other []string
+	for _, pkg := range []struct {
+		ref   string
+		path  string
+		isStd bool
+	}{
+		{"context.", `"context"`, true},
+		{"fmt.", `"fmt"`, true},
+		{"ledger.", fmt.Sprintf(`"%s/pkg/ledger"`, projectPath), false},
+		{"state.", fmt.Sprintf(`"%s/state"`, projectPath), false},
+		{"graphql.", `graphql "github.com/graph-gophers/graphql-go"`, false},
+	} {
+		if !strings.Contains(body, pkg.ref) || strings.Contains(body, "import "+pkg.path) {
+			continue
+		}
+		if pkg.isStd {
+			std = append(std, pkg.path)
+		} else {
+			other = append(other, pkg.path)
+		}
+	}
+	if len(std) == 0 && len(other) == 0 {
+		return
+	}
+	f.printf("import (\n%s\n\n%s\n)", strings.Join(std, "\n"), strings.Join(other, "\n"))
+}
+
+// goRequire is a module required by a generated project.
+type goRequire struct {
+	path    string
+	version string
+}
+
+// goVersion is the Go version generated projects are written for.
+const goVersion = "1.21"
+
+func (f *File) WriteGoModule(module string, requires []goRequire) {
+	f.printf("module %s", module)
+	f.printf("")
+	f.printf("go %s", goVersion)
+	f.printf("")
+	f.printf("require (")
+	for _, r := range requires {
+		f.printf("\t%s %s", r.path, r.version)
+	}
+	f.printf(")")
+}
+
+func (f *File) WriteStateEnums(enums []def.TypeDef) {
+	f.printf("%s\n", doNotEditHeader)
+	f.printf("package state")
+	f.printf("import \"fmt\"")
+	for _, e := range enums {
+		f.printf("// %s is the %s GraphQL enum.", e.Name, e.Name)
+		f.printf("type %s string\n", e.Name)
+
+		f.printf("const (")
+		for _, v := range e.EnumValues {
+			f.printf("%s %s = \"%s\"", enumConstName(e.Name, v), e.Name, v)
+		}
+		f.printf(")\n")
+
+		f.printf("// IsValid reports whether the value is a member of %s.", e.Name)
+		f.printf("func (e %s) IsValid() bool {", e.Name)
+		f.printf("switch e {")
+		var names []string
+		for _, v := range e.EnumValues {
+			names = append(names, enumConstName(e.Name, v))
+		}
+		f.printf("case %s:", strings.Join(names, ", "))
+		f.printf("return true")
+		f.printf("}")
+		f.printf("return false")
+		f.printf("}\n")
+
+		f.printf("func (e %s) String() string {", e.Name)
+		f.printf("return string(e)")
+		f.printf("}\n")
+
+		f.printf("func (%s) ImplementsGraphQLType(name string) bool {", e.Name)
+		f.printf("return name == \"%s\"", e.Name)
+		f.printf("}\n")
+
+		f.printf("func (e *%s) UnmarshalGraphQL(input interface{}) error {", e.Name)
+		f.printf("str, ok := input.(string)")
+		f.printf("if !ok {")
+		f.printf("return fmt.Errorf(\"%s must be a string, got %%T\", input)", e.Name)
+		f.printf("}")
+		f.printf("if !%s(str).IsValid() {", e.Name)
+		f.printf("return fmt.Errorf(\"%%q is not a valid %s\", str)", e.Name)
+		f.printf("}")
+		f.printf("*e = %s(str)", e.Name)
+		f.printf("return nil")
+		f.printf("}\n")
+	}
+}
+
+// WriteScalars writes a type for each custom scalar that wraps the scalar's
+// Go type and converts it to and from JSON using the scalar's codecs.
+func (f *File) WriteScalars(scalars []def.ScalarDef) {
+	f.printf("%s\n", doNotEditHeader)
+	f.printf("package api")
+
+	var imports []string
+	seen := map[string]bool{}
+	for _, s := range scalars {
+		if s.GoImport != "" && !seen[s.GoImport] {
+			seen[s.GoImport] = true
+			imports = append(imports, fmt.Sprintf("%q", s.GoImport))
+		}
+	}
+	if len(imports) > 0 {
+		sort.Strings(imports)
+		f.printf("import (\n%s\n)", strings.Join(imports, "\n"))
+	}
+
 	for _, s := range scalars {
-		f.printf("// %s Scalar\n", s.Name)
-		f.printf("type %s struct {\n\t// TODO: Implement\n}\n", s.Name)
+		f.printf("// %s Scalar", s.Name)
+		f.printf("type %s struct {", s.Name)
+		f.printf("Value %s", s.GoType)
+		f.printf("}\n")
 
 		f.printf("func (%s) ImplementsGraphQLType(name string) bool {", s.Name)
 		f.printf("return name == \"%s\"", s.Name)
 		f.printf("}\n")
 
 		f.printf("func (s *%s) UnmarshalGraphQL(input interface{}) error {", s.Name)
-		f.printf("return fmt.Errorf(\"Not Implemented\")")
+		f.printf("v, err := %s(input)", s.GoUnmarshal)
+		f.printf("if err != nil {")
+		f.printf("return err")
+		f.printf("}")
+		f.printf("s.Value = v")
+		f.printf("return nil")
 		f.printf("}\n")
+
+		f.printf("func (s %s) MarshalJSON() ([]byte, error) {", s.Name)
+		f.printf("return %s(s.Value)", s.GoMarshal)
+		f.printf("}\n")
+	}
+}
+
+// goArgs returns the argument struct parameter for a root resolver method or
+// an empty string when there are no arguments: `, args struct{ Name string }`
+func goArgs(in def.ArgDefs) string {
+	if len(in) == 0 {
+		return ""
+	}
+	return ", " + goArgsStruct(in)
+}
+
+// goArgsStruct returns the argument struct parameter of a resolver method:
+// `args struct{ Name string }`. Arguments with a default value are never
+// null, so they aren't pointers.
+func goArgsStruct(in def.ArgDefs) string {
+	var fields []string
+	for _, arg := range in {
+		t := arg.Type
+		if arg.HasDefault {
+			t.IsOptional = false
+		}
+		fields = append(fields, fmt.Sprintf("%s %s", strings.Title(arg.Name), goInputType(t)))
 	}
+	return fmt.Sprintf("args struct{ %s }", strings.Join(fields, "; "))
 }
 
-func isList(in def.TypeDef) string {
-	if in.IsList && in.IsOptional {
-		return "*[]"
+// writeNotImplemented writes the return statement of a field resolver stub.
+func (f *File) writeNotImplemented(field def.FieldDef) {
+	switch {
+	case field.Type.IsScalar, field.Type.IsEnum:
+		f.printf("return %s", goZeroValue(goResolverType(field.Type)))
+	default:
+		f.printf("return %s, fmt.Errorf(\"Not Implemented\")", goZeroValue(goResolverType(field.Type)))
+	}
+}
+
+// goFieldSignature returns the method signature of a field resolver, which is
+// shared by object resolvers and the interfaces they implement. Fields with
+// arguments take them as an args struct, connectionArgs for connections.
+func goFieldSignature(field def.FieldDef) string {
+	name := strings.Title(field.Name)
+	var args string
+	switch {
+	case isConnection(field.Type, field.Arguments):
+		args = "args connectionArgs"
+	case len(field.Arguments) > 0:
+		args = goArgsStruct(field.Arguments)
+	}
+	if field.Type.IsScalar || field.Type.IsEnum {
+		return fmt.Sprintf("%s(%s) %s", name, args, goResolverType(field.Type))
 	}
-	if in.IsList && !in.IsOptional {
-		return "[]"
+	return fmt.Sprintf("%s(%s) (%s, error)", name, args, goResolverType(field.Type))
+}
+
+// isConnectionFunc reports whether fn returns a connection and only accepts
+// the arguments in connectionArgs.
+func isConnectionFunc(fn def.FuncDef) bool {
+	return isConnection(fn.Return, fn.Arguments)
+}
+
+// connectionArgTypes are the types of the arguments in connectionArgs.
+var connectionArgTypes = map[string]string{"first": "Int", "after": "String", "last": "Int", "before": "String"}
+
+// isConnection reports whether a field of type t returns a connection whose
+// page is given by connectionArgs. Fetch needs 'first' or 'last', so at least
+// one argument is required and every argument must be nullable without a
+// default, as connectionArgs holds pointers.
+func isConnection(t def.TypeDef, args def.ArgDefs) bool {
+	if !t.IsConnection || t.IsList || len(args) == 0 {
+		return false
+	}
+	for _, arg := range args {
+		name, ok := connectionArgTypes[arg.Name]
+		if !ok || arg.Type.Name != name || arg.Type.IsList || !arg.Type.IsOptional || arg.HasDefault {
+			return false
+		}
+	}
+	return true
+}
+
+// isNodeFunc reports whether fn is a Relay style `node(id: ID!): Node` query
+// that resolves any type implementing Node by its ID. Other queries by ID
+// returning an interface are resolved by the user.
+func isNodeFunc(fn def.FuncDef) bool {
+	if !fn.Return.IsInterface || fn.Return.Name != "Node" || fn.Return.IsList || len(fn.Arguments) != 1 {
+		return false
+	}
+	arg := fn.Arguments[0]
+	return arg.Name == "id" && arg.Type.Name == "ID" && !arg.Type.IsList && !arg.Type.IsOptional
+}
+
+// goInputType returns the Go type graph-gophers/graphql-go expects for an argument
+// or input field: nullable values are pointers, lists are slices, IDs are
+// graphql.ID and input objects are structs.
+func goInputType(in def.TypeDef) string {
+	var out string
+	if in.IsList && in.OfType != nil {
+		out = "[]" + goInputType(*in.OfType)
+	} else {
+		out = goTypeName(in)
+	}
+	if in.IsOptional {
+		return "*" + out
+	}
+	return out
+}
+
+// goTypeName returns the Go name of a named GraphQL scalar, enum or input.
+func goTypeName(in def.TypeDef) string {
+	if in.IsEnum {
+		return "state." + in.Name
+	}
+	if in.IsScalar {
+		return def.ToGoScalar(in.Name)
+	}
+	return in.Name
+}
+
+// goResolverType returns the Go type a resolver method returns for a given
+// TypeDef, following the same rules as goInputType. Objects, interfaces and
+// unions map to their resolvers.
+func goResolverType(in def.TypeDef) string {
+	var out string
+	switch {
+	case in.IsList && in.OfType != nil:
+		out = "[]" + goResolverType(*in.OfType)
+	case in.IsScalar || in.IsEnum:
+		out = goTypeName(in)
+	default:
+		return fmt.Sprintf("*%sResolver", lowerFirstLetter(in.Name))
+	}
+	if in.IsOptional {
+		return "*" + out
+	}
+	return out
+}
+
+// goStateType returns the Go type of a state struct field. Lists are slices
+// of values and nullable values are pointers. IDs are strings and custom
+// scalars use the Go type they're configured with.
+func goStateType(in def.TypeDef, scalars map[string]def.ScalarDef) string {
+	var out string
+	switch {
+	case in.IsList && in.OfType != nil:
+		elem := *in.OfType
+		elem.IsOptional = false
+		return "[]" + goStateType(elem, scalars)
+	case in.IsScalar && in.Name == "ID":
+		out = "string"
+	case in.IsScalar:
+		out = def.ToGoScalar(in.Name)
+		if s, ok := scalars[in.Name]; ok {
+			out = s.GoType
+		}
+	default:
+		out = in.Name
+	}
+	if in.IsOptional {
+		return "*" + out
+	}
+	return out
+}
+
+// goStateToAPI returns the expression that converts a state value to the
+// value its resolver returns. Object values are pointers when isPtr is true.
+func goStateToAPI(value string, t def.TypeDef, isPtr bool) string {
+	switch {
+	case isObject(t) && isPtr:
+		return fmt.Sprintf("&%sResolver{%s: %s, Backends: r.Backends}", lowerFirstLetter(t.Name), lowerFirstLetter(t.Name), value)
+	case isObject(t):
+		return fmt.Sprintf("&%sResolver{%s: &%s, Backends: r.Backends}", lowerFirstLetter(t.Name), lowerFirstLetter(t.Name), value)
+	case t.IsEnum:
+		return fmt.Sprintf("state.%s(%s)", t.Name, value)
+	case t.Name == "ID":
+		return fmt.Sprintf("encodeID(%s)", value)
+	case !def.IsGraphQLScalar(t.Name):
+		return fmt.Sprintf("%s{Value: %s}", t.Name, value)
+	}
+	return value
+}
+
+// fieldStub is a field resolver that's implemented by the user.
+type fieldStub struct {
+	resolver string
+	field    def.FieldDef
+}
+
+// connectionFields and edgeFields are the fields of connections and their
+// edges that are resolved from state.
+var (
+	connectionFields = map[string]bool{"edges": true, "pageInfo": true, "total": true, "totalCount": true}
+	edgeFields       = map[string]bool{"node": true, "cursor": true}
+)
+
+// resolverStubs returns the fields of t that writeFieldResolver can't
+// resolve from state.
+func resolverStubs(t def.TypeDef) []fieldStub {
+	var out []fieldStub
+	for _, field := range t.Fields {
+		if !isResolvable(field.Type) && !isObjectConnection(t, field) {
+			out = append(out, fieldStub{lowerFirstLetter(t.Name) + "Resolver", field})
+		}
+	}
+	return out
+}
+
+// connectionStubs returns the fields of a connection and its edge that
+// aren't resolved from state.
+func connectionStubs(conn def.ConnectionDef) []fieldStub {
+	var out []fieldStub
+	for _, field := range conn.Type.Fields {
+		if !connectionFields[field.Name] {
+			out = append(out, fieldStub{lowerFirstLetter(conn.Type.Name) + "Resolver", field})
+		}
+	}
+	for _, field := range conn.Edge.Fields {
+		if !edgeFields[field.Name] {
+			out = append(out, fieldStub{lowerFirstLetter(conn.Edge.Name) + "Resolver", field})
+		}
+	}
+	return out
+}
+
+// objectConnection is a connection field of an object that pages through the
+// records connected to the object by edges of the field's kind.
+type objectConnection struct {
+	object def.TypeDef
+	field  def.FieldDef
+	conn   def.ConnectionDef
+}
+
+// name returns the name of the field's state methods, e.g. PostComments.
+func (oc objectConnection) name() string {
+	return oc.object.Name + strings.Title(oc.field.Name)
+}
+
+// edgeKind returns the kind of the edges from the object to the records of
+// the field, e.g. post.comments.
+func (oc objectConnection) edgeKind() string {
+	return strings.ToLower(oc.object.Name) + "." + oc.field.Name
+}
+
+// objectConnections returns the connection fields of objects that are
+// fetched from state.
+func objectConnections(d def.Definition) []objectConnection {
+	var out []objectConnection
+	for _, obj := range d.Objects {
+		for _, field := range obj.Fields {
+			if !isObjectConnection(obj, field) {
+				continue
+			}
+			if conn, ok := d.Connection(field.Type.Name); ok {
+				out = append(out, objectConnection{object: obj, field: field, conn: conn})
+			}
+		}
+	}
+	return out
+}
+
+// isObjectConnection reports whether a connection field of t is fetched from
+// state. Only Node objects have the ID their edges are stored with.
+func isObjectConnection(t def.TypeDef, field def.FieldDef) bool {
+	return implements(t, "Node") && isConnection(field.Type, field.Arguments)
+}
+
+// isGeneratedFunc reports whether the root resolver of fn is generated.
+func isGeneratedFunc(fn def.FuncDef) bool {
+	return isNodeFunc(fn) || isConnectionFunc(fn)
+}
+
+// isResolvable reports whether writeFieldResolver can resolve a field of type
+// t from state. Interfaces, unions and connections aren't stored on state
+// structs.
+func isResolvable(t def.TypeDef) bool {
+	return isStateField(t) && !(t.IsList && (t.OfType == nil || t.OfType.IsList))
+}
+
+// isIdentity reports whether a state value of t is returned by its resolver
+// as is.
+func isIdentity(t def.TypeDef) bool {
+	return t.IsScalar && t.Name != "ID" && def.IsGraphQLScalar(t.Name)
+}
+
+// isObject reports whether t is an object with a state struct.
+func isObject(t def.TypeDef) bool {
+	return !t.IsScalar && !t.IsEnum && isStateField(t)
+}
+
+// isStateField reports whether values of t are stored on state structs.
+func isStateField(t def.TypeDef) bool {
+	return !t.IsInterface && !t.IsUnion && !t.IsConnection && t.Name != "PageInfo"
+}
+
+// nodeFields are the fields of objects implementing Node that are provided by
+// the embedded state.Node.
+var nodeFields = map[string]bool{"id": true, "created": true, "modified": true}
+
+func nodeObjects(objects []def.TypeDef) []def.TypeDef {
+	var out []def.TypeDef
+	for _, t := range objects {
+		if implements(t, "Node") {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+func implements(t def.TypeDef, intf string) bool {
+	for _, name := range t.Interfaces {
+		if name == intf {
+			return true
+		}
+	}
+	return false
+}
+
+// goZeroValue returns the zero value literal for a Go type returned by
+// goResolverType.
+func goZeroValue(in string) string {
+	switch {
+	case strings.HasPrefix(in, "*"), strings.HasPrefix(in, "[]"):
+		return "nil"
+	case in == "bool":
+		return "false"
+	case in == "int32", in == "float64":
+		return "0"
+	case in == "string", in == "graphql.ID", strings.HasPrefix(in, "state."):
+		return `""`
+	}
+	return in + "{}"
+}
+
+func joinTypeNames(in []def.TypeDef) string {
+	var names []string
+	for _, t := range in {
+		names = append(names, t.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// enumConstName returns the Go constant name for an enum value: the enum
+// name followed by the title cased value, e.g. `StatusSuperUser`.
+func enumConstName(enum string, value string) string {
+	parts := strings.Split(strings.ToLower(value), "_")
+	for i, part := range parts {
+		parts[i] = strings.Title(part)
 	}
-	return ""
+	return enum + strings.Join(parts, "")
 }
 
 func lowerFirstLetter(in string) string {
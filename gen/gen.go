@@ -1,10 +1,14 @@
 package gen
 
 import (
+	"bytes"
 	"fmt"
+	"io/fs"
 	"io/ioutil"
 	"os"
+	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -19,19 +23,25 @@ const (
 )
 
 var ignoredFiles = map[string]bool{
-	"":          true,
 	".DS_Store": true,
-	"templates": true,
 }
 
 type Project struct {
-	Name       string // The name of the project
-	Domain     string // The domain the project is hosted on e.g. example.com
-	Definition def.Definition
-	Clients    []Client
-	Templates  TemplateFiles
-	dest       string
-	err        error
+	Name            string // The name of the project
+	Module          string // The Go module path of the project e.g. github.com/example/app
+	Domain          string // The domain the project is hosted on e.g. example.com
+	Schema          string // The name of the GraphQL schema file copied into the project
+	Definition      def.Definition
+	Clients         []Client
+	Templates       TemplateFiles
+	scalars         map[string]def.ScalarDef // Configured custom scalars keyed by lowercase name
+	templates       fs.FS                    // Builtin templates
+	templateDir     string                   // Local directory overriding the builtin templates
+	templateFS      map[string]fs.FS         // Template name : file system it's read from
+	templateSources map[string]string        // Template name : source recorded in the manifest
+	output          *Output
+	dest            string
+	err             error
 }
 
 type Client struct {
@@ -41,30 +51,50 @@ type Client struct {
 	TeamID       string // Team identifier, generally used for iOS clients
 	HasBackend   bool
 	HasTests     bool
+	SwiftPackage bool // The iOS Kit is a Swift package
+	XcodeGen     bool // The iOS client has an XcodeGen project.yml
 	Templates    TemplateFiles
 }
 
 type ClientKind string
 
 const (
-	IOSClientKind ClientKind = "ios"
-	WebClientKind            = "www"
+	IOSClientKind     ClientKind = "ios"
+	AndroidClientKind ClientKind = "android"
+	WebClientKind     ClientKind = "www"
 )
 
 type TemplateFiles map[string]string // template name : file-path
 
+// stateTemplateTypes are the state types written by the state templates, or
+// generated apart from the other objects in the case of Account, rather than
+// generated from the schema.
+var stateTemplateTypes = map[string]bool{
+	"Account":  true,
+	"Node":     true,
+	"Edge":     true,
+	"PageInfo": true,
+}
+
 // NewProject returns a new Project.
 func NewProject(name string, dest string, domain string) *Project {
 	return &Project{
-		Name:      strings.ToLower(name),
-		Domain:    domain,
-		Templates: make(TemplateFiles),
-		dest:      dest,
+		Name:            strings.ToLower(name),
+		Module:          strings.ToLower(name),
+		Domain:          domain,
+		Templates:       make(TemplateFiles),
+		scalars:         make(map[string]def.ScalarDef),
+		templateFS:      make(map[string]fs.FS),
+		templateSources: make(map[string]string),
+		output:          NewOutput(filepath.Join(dest, strings.ToLower(name)), WriteMode),
+		dest:            dest,
 	}
 }
 
-// AddIOSClient appends a new iOS client to the Project.
-func (p *Project) AddIOSClient(name string, teamID string, hasBackend bool, hasTests bool) {
+// AddIOSClient appends a new iOS client to the Project. Its Kit is written as
+// a Swift package when swiftPackage is set, and project.yml only when xcodeGen
+// is set.
+func (p *Project) AddIOSClient(name string, teamID string, hasBackend bool, hasTests bool, swiftPackage bool, xcodeGen bool) {
 	fmt.Printf("Adding iOS client: %s\n", name)
 	client := Client{
 		Kind:         IOSClientKind,
@@ -73,11 +103,91 @@ func (p *Project) AddIOSClient(name string, teamID string, hasBackend bool, hasT
 		TeamID:       teamID,
 		HasBackend:   hasBackend,
 		HasTests:     hasTests,
+		SwiftPackage: swiftPackage,
+		XcodeGen:     xcodeGen,
+		Templates:    make(TemplateFiles),
+	}
+	p.Clients = append(p.Clients, client)
+}
+
+// AddAndroidClient appends a new Android client to the Project.
+func (p *Project) AddAndroidClient(name string) {
+	fmt.Printf("Adding Android client: %s\n", name)
+	client := Client{
+		Kind:         AndroidClientKind,
+		Name:         name,
+		BundleDomain: reverseDomain(p.Domain),
 		Templates:    make(TemplateFiles),
 	}
 	p.Clients = append(p.Clients, client)
 }
 
+// AddWebClient appends a new web client to the Project.
+func (p *Project) AddWebClient(name string) {
+	fmt.Printf("Adding web client: %s\n", name)
+	client := Client{
+		Kind:      WebClientKind,
+		Name:      name,
+		Templates: make(TemplateFiles),
+	}
+	p.Clients = append(p.Clients, client)
+}
+
+// AddScalars configures the Go and Swift types and codecs used for custom
+// scalars. Configuration overrides the builtin scalars of the same name.
+func (p *Project) AddScalars(config map[string]def.ScalarDef) {
+	for name, scalar := range config {
+		p.scalars[strings.ToLower(name)] = scalar
+	}
+}
+
+// ScalarDefs returns the ScalarDef for every custom scalar in the schema.
+// Invalid scalar configurations are reported by ReadGraphQLSchema.
+func (p *Project) ScalarDefs() []def.ScalarDef {
+	out, _ := p.scalarDefs()
+	return out
+}
+
+func (p *Project) scalarDefs() ([]def.ScalarDef, error) {
+	var out []def.ScalarDef
+	for _, s := range p.Definition.Scalars {
+		if def.IsGraphQLScalar(s.Name) {
+			continue
+		}
+		scalar, err := def.NewScalar(s.Name, p.scalars[strings.ToLower(s.Name)])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, scalar)
+	}
+	return out, nil
+}
+
+// SetOutputMode sets whether files are written to disk or only reported, see
+// OutputMode.
+func (p *Project) SetOutputMode(mode OutputMode) {
+	p.output = NewOutput(p.output.root, mode)
+}
+
+// SetModule sets the Go module path of the Project, it defaults to the
+// Project's name.
+func (p *Project) SetModule(module string) {
+	if module != "" {
+		p.Module = module
+	}
+}
+
+// SetTemplates sets the builtin templates the Project is rendered from.
+func (p *Project) SetTemplates(fsys fs.FS) {
+	p.templates = fsys
+}
+
+// SetTemplateDir sets a local directory of templates that override or add to
+// the builtin templates when the Project is written.
+func (p *Project) SetTemplateDir(dir string) {
+	p.templateDir = dir
+}
+
 // ReadGraphQLSchema reads a GraphQL schema and adds a new Definition to the
 // Project that will be used when rendering project templates.
 func (p *Project) ReadGraphQLSchema(filename string) {
@@ -96,6 +206,8 @@ func (p *Project) ReadGraphQLSchema(filename string) {
 		return
 	}
 	p.Definition = def.New(schema)
+	p.Schema = filepath.Base(filename)
+	_, p.err = p.scalarDefs()
 }
 
 // Write renders all the Project files and writes them out to their
@@ -105,13 +217,47 @@ func (p *Project) Write() {
 		return
 	}
 	fmt.Println("Writing Templates...")
-	p.ReadTemplates(_escData)
+	p.ReadTemplates(p.templates, templatesFolder)
+	if p.templateDir != "" {
+		fmt.Printf("Using templates: %s\n", p.templateDir)
+		p.ReadTemplates(os.DirFS(p.templateDir), p.templateDir)
+	}
 	fmt.Println("Writing template files...")
 	p.WriteTemplateFiles()
+	fmt.Println("Writing Go module...")
+	p.WriteGoModule()
+	fmt.Println("Writing Go state scaffolding...")
+	p.WriteGoScaffoldingForState()
 	fmt.Println("Writing Go API scaffolding...")
 	p.WriteGoScaffoldingForAPI()
-	fmt.Println("Writing Swift GraphQL scaffolding...")
-	p.WriteSwiftScaffoldingForGraphQL()
+	fmt.Println("Writing Go client...")
+	p.WriteGoClient()
+	fmt.Println("Writing GraphQL scaffolding...")
+	p.WriteGraphQLScaffolding()
+	if p.err != nil {
+		return
+	}
+	fmt.Println("Removing stale files...")
+	p.keepUnconfiguredClients()
+	p.err = p.output.Finish()
+}
+
+// clientKinds are the kinds of client a Project can have.
+var clientKinds = []ClientKind{IOSClientKind, AndroidClientKind, WebClientKind}
+
+// keepUnconfiguredClients keeps the files of clients generated before that
+// aren't configured in this run, so leaving out a client's flags doesn't
+// remove it.
+func (p *Project) keepUnconfiguredClients() {
+	configured := make(map[ClientKind]bool)
+	for _, client := range p.Clients {
+		configured[client.Kind] = true
+	}
+	for _, kind := range clientKinds {
+		if !configured[kind] {
+			p.output.Keep(clientsFolder + "/" + string(kind))
+		}
+	}
 }
 
 // Err returns the first encountered error.
@@ -119,37 +265,50 @@ func (p *Project) Err() error {
 	return p.err
 }
 
-// ReadTemplates reads all the statically generated templates into the Project.
-func (p *Project) ReadTemplates(templates map[string]*_escFile) {
-	for name, file := range templates {
-		filename := strings.TrimPrefix(file.local, templatesFolder+"/")
-
-		// Ignore files
-		if ignoredFiles[filename] {
-			continue
+// ReadTemplates reads the templates in a file system into the Project, root
+// names the file system in the manifest. Templates override the templates
+// with the same path that were read before, e.g. api/api.go in a local
+// directory replaces the embedded api/api.go.
+func (p *Project) ReadTemplates(fsys fs.FS, root string) {
+	if p.err != nil || fsys == nil {
+		return
+	}
+	p.err = fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		if p.addTemplate(name) {
+			p.templateFS[name] = fsys
+			p.templateSources[name] = path.Join(filepath.ToSlash(root), name)
 		}
+		return nil
+	})
+}
 
-		// Rename files prefixed with 'Project' to the actual project name
-		filename = strings.Replace(filename, projectPrefix, strings.Title(p.Name), -1)
+// addTemplate adds the template with the given name to the Project or one of
+// its clients. The name is the template's path relative to the templates
+// folder. Returns false for ignored files.
+func (p *Project) addTemplate(name string) bool {
+	// Ignore files
+	if ignoredFiles[path.Base(name)] {
+		return false
+	}
 
-		// Skip directories
-		if file.isDir {
-			continue
-		}
+	// Rename files prefixed with 'Project' to the actual project name
+	filename := strings.Replace(name, projectPrefix, strings.Title(p.Name), -1)
 
-		// Check for client templates
-		if strings.HasPrefix(filename, clientsFolder) {
-			for i, client := range p.Clients {
-				prefix := fmt.Sprintf("%s/%s", clientsFolder, string(client.Kind))
-				if strings.HasPrefix(filename, prefix) {
-					p.Clients[i].Templates[name] = filename
-					continue
-				}
+	// Check for client templates
+	if strings.HasPrefix(filename, clientsFolder) {
+		for i, client := range p.Clients {
+			prefix := fmt.Sprintf("%s/%s", clientsFolder, string(client.Kind))
+			if strings.HasPrefix(filename, prefix) && !client.skips(filename) {
+				p.Clients[i].Templates[name] = filename
 			}
-		} else {
-			p.Templates[name] = filename
 		}
+	} else {
+		p.Templates[name] = filename
 	}
+	return true
 }
 
 // WriteTemplateFiles writes all rendered templates to the file system.
@@ -160,7 +319,9 @@ func (p *Project) WriteTemplateFiles() {
 	}
 }
 
-// Copy copies a given file to the project root.
+// Copy copies a given file to the project root. The copy is replaced every
+// time the project is generated so it matches the file it was copied from,
+// GraphQL files are marked as generated.
 func (p *Project) Copy(filename string) {
 	data, err := ioutil.ReadFile(filename)
 	if err != nil {
@@ -169,69 +330,264 @@ func (p *Project) Copy(filename string) {
 	}
 
 	ext := filepath.Ext(filename)
-	name := filename[0 : len(filename)-len(ext)]
+	name := strings.TrimSuffix(filepath.Base(filename), ext)
 
-	file := NewFile(name, ext)
+	file := p.newFile(name, strings.TrimPrefix(ext, "."))
+	file.source = filename
+	file.overwrite = true
+	if ext == ".graphql" && !isGenerated(data) {
+		file.printf("# Code generated by startapp from %s. DO NOT EDIT.\n", filepath.Base(filename))
+	}
 	file.WriteBytes(data)
 	file.Write(p.dest, p.Name)
 	file.PanicOnErr()
 }
 
+// goRequires are the modules required by the Go templates and scaffolding.
+var goRequires = []goRequire{
+	{"github.com/graph-gophers/graphql-go", "v1.5.0"},
+	{"github.com/jmoiron/sqlx", "v1.4.0"},
+	{"github.com/lib/pq", "v1.10.9"},
+	{"github.com/satori/go.uuid", "v1.2.0"},
+	{"github.com/spf13/cobra", "v1.8.1"},
+	{"github.com/spf13/viper", "v1.19.0"},
+	{"golang.org/x/crypto", "v0.31.0"},
+}
+
+// WriteGoModule writes the go.mod file of the Project. It's written once and
+// belongs to the user afterwards, run 'go mod tidy' to complete it.
+func (p *Project) WriteGoModule() {
+	if p.err != nil {
+		return
+	}
+	file := p.newFile("go", "mod")
+	file.WriteGoModule(p.Module, goRequires)
+	file.Write(p.dest, p.Name)
+	file.PanicOnErr()
+}
+
+// WriteGoScaffoldingForState writes the state types that are derived from the
+// schema, such as enums, connections and objects.
+func (p *Project) WriteGoScaffoldingForState() {
+	root := filepath.Join(p.dest, p.Name)
+	dir := "state"
+
+	if len(p.Definition.Enums) > 0 {
+		file := p.newFile("enums_gen", "go")
+		file.WriteStateEnums(p.Definition.Enums)
+		file.GoFormat()
+		file.Write(root, dir)
+		file.PanicOnErr()
+	}
+
+	// Connections are always written because the Stater interface embeds
+	// ConnectionStater.
+	connections := p.Definition.Connections()
+	fields := objectConnections(p.Definition)
+	file := p.newFile("connections_gen", "go")
+	file.WriteStateConnections(connections, fields)
+	file.GoFormat()
+	file.Write(root, dir)
+	file.PanicOnErr()
+
+	file = p.newFile("connections_gen", "go")
+	file.WritePostgresConnections(p.Module, connections, fields)
+	file.GoFormat()
+	file.Write(root, dir, "postgres")
+	file.PanicOnErr()
+
+	// Objects are always written because the Stater interface embeds
+	// ObjectStater.
+	skip := make(map[string]bool)
+	for name := range stateTemplateTypes {
+		skip[name] = true
+	}
+	for _, conn := range connections {
+		skip[conn.Type.Name] = true
+		skip[conn.Edge.Name] = true
+	}
+	var objects []def.TypeDef
+	for _, obj := range p.Definition.Objects {
+		if !skip[obj.Name] {
+			objects = append(objects, obj)
+		}
+	}
+
+	// Account is always written because AccountStater and the auth
+	// templates depend on it.
+	account := def.TypeDef{Name: "Account", Interfaces: []string{"Node"}}
+	if obj, ok := p.Definition.Object("Account"); ok {
+		account = obj
+	}
+	file = p.newFile("account_gen", "go")
+	file.WriteStateAccount(p.DataTypeNamespace(), account, p.ScalarDefs())
+	file.GoFormat()
+	file.Write(root, dir)
+	file.PanicOnErr()
+
+	file = p.newFile("objects_gen", "go")
+	file.WriteStateObjects(p.DataTypeNamespace(), objects, p.ScalarDefs())
+	file.GoFormat()
+	file.Write(root, dir)
+	file.PanicOnErr()
+
+	file = p.newFile("objects_gen", "go")
+	file.WritePostgresObjects(p.Module, objects)
+	file.GoFormat()
+	file.Write(root, dir, "postgres")
+	file.PanicOnErr()
+}
+
 // WriteGoScaffoldingForAPI writes all the api scaffolding.
 func (p *Project) WriteGoScaffoldingForAPI() {
 	root := filepath.Join(p.dest, p.Name)
 	dir := "api"
 
+	// Connections, their edges and PageInfo are resolved by the connection
+	// resolvers and the pageInfoResolver template.
+	skip := map[string]bool{"PageInfo": true}
+	for _, conn := range p.Definition.Connections() {
+		skip[conn.Type.Name] = true
+		skip[conn.Edge.Name] = true
+		file := p.newFile(strings.ToLower(conn.Type.Name)+"_gen", "go")
+		file.WriteAPIConnectionResolver(p.Module, conn)
+		file.GoFormat()
+		file.Write(root, dir)
+		file.PanicOnErr()
+
+		if stubs := connectionStubs(conn); len(stubs) > 0 {
+			p.writeAPIFieldStubs(strings.ToLower(conn.Type.Name), stubs)
+		}
+	}
 	for _, obj := range p.Definition.Objects {
-		file := NewFile(strings.ToLower(obj.Name), "go")
-		file.WriteAPIResolver("github.com/nathanborror/"+root, obj)
+		if skip[obj.Name] {
+			continue
+		}
+		file := p.newFile(strings.ToLower(obj.Name)+"_gen", "go")
+		file.WriteAPIResolver(p.Module, obj)
+		file.GoFormat()
+		file.Write(root, dir)
+		file.PanicOnErr()
+
+		if stubs := resolverStubs(obj); len(stubs) > 0 {
+			p.writeAPIFieldStubs(strings.ToLower(obj.Name), stubs)
+		}
+	}
+	for _, intf := range p.Definition.Interfaces {
+		file := p.newFile(strings.ToLower(intf.Name)+"_gen", "go")
+		file.WriteAPIInterfaceResolver(p.Module, intf)
 		file.GoFormat()
 		file.Write(root, dir)
 		file.PanicOnErr()
 	}
-	if len(p.Definition.Scalars) > 0 {
-		file := NewFile("scalars", "go")
-		file.WriteScalars(p.Definition.Scalars)
+	for _, union := range p.Definition.Unions {
+		file := p.newFile(strings.ToLower(union.Name)+"_gen", "go")
+		file.WriteAPIUnionResolver(union)
 		file.GoFormat()
 		file.Write(root, dir)
 		file.PanicOnErr()
 	}
-	if len(p.Definition.Queries) > 0 {
-		file := NewFile("queries", "go")
-		file.WriteAPIQueries("github.com/nathanborror/" + root)
+	if scalars := p.ScalarDefs(); len(scalars) > 0 {
+		file := p.newFile("scalars_gen", "go")
+		file.WriteScalars(scalars)
 		file.GoFormat()
 		file.Write(root, dir)
 		file.PanicOnErr()
 	}
-	if len(p.Definition.Mutations) > 0 {
-		file := NewFile("mutations", "go")
-		file.WriteAPIMutations("github.com/nathanborror/" + root)
+	if len(p.Definition.Inputs) > 0 {
+		file := p.newFile("inputs_gen", "go")
+		file.WriteAPIInputs(p.Module, p.Definition.Inputs)
 		file.GoFormat()
 		file.Write(root, dir)
 		file.PanicOnErr()
 	}
+	p.writeAPIRootResolvers("queries", p.Definition.Queries, false)
+	p.writeAPIRootResolvers("mutations", p.Definition.Mutations, false)
+	p.writeAPIRootResolvers("subscriptions", p.Definition.Subscriptions, true)
 }
 
-// WriteSwiftScaffoldingForGraphQL writes empty '.graphql' files to the client's
-// iOS GraphQL directory. Use these stubs to write your GraphQL queries for your
-// clients to invoke.
-func (p *Project) WriteSwiftScaffoldingForGraphQL() {
-	var iosClient Client
+// writeAPIRootResolvers writes the generated root resolvers for fns to
+// '<name>_gen.go' and the ones the user implements to '<name>.go'.
+func (p *Project) writeAPIRootResolvers(name string, fns []def.FuncDef, isSubscription bool) {
+	root := filepath.Join(p.dest, p.Name)
+	dir := "api"
 
-	// Find iOS Client
+	var generated, stubs int
+	for _, fn := range fns {
+		if !isSubscription && isGeneratedFunc(fn) {
+			generated++
+		} else {
+			stubs++
+		}
+	}
+	if generated > 0 {
+		file := p.newFile(name+"_gen", "go")
+		file.WriteAPIRootResolvers(p.Module, fns)
+		file.GoFormat()
+		file.Write(root, dir)
+		file.PanicOnErr()
+	}
+	if stubs > 0 {
+		file := p.newFile(name, "go")
+		file.ReadRegions(root, dir)
+		file.WriteAPIRootResolverStubs(p.Module, fns, isSubscription)
+		file.GoFormat()
+		file.Write(root, dir)
+		file.PanicOnErr()
+	}
+}
+
+// writeAPIFieldStubs writes the field resolvers the user implements to
+// '<name>.go'.
+func (p *Project) writeAPIFieldStubs(name string, stubs []fieldStub) {
+	root := filepath.Join(p.dest, p.Name)
+	dir := "api"
+
+	file := p.newFile(name, "go")
+	file.ReadRegions(root, dir)
+	file.WriteAPIFieldStubs(p.Module, stubs)
+	file.GoFormat()
+	file.Write(root, dir)
+	file.PanicOnErr()
+}
+
+// WriteGoClient writes the types and operations of the Go client package that
+// calls the API.
+func (p *Project) WriteGoClient() {
+	if p.err != nil {
+		return
+	}
+	file := p.newFile("client_gen", "go")
+	file.WriteClient(p.Definition, p.ScalarDefs())
+	file.GoFormat()
+	file.Write(p.dest, p.Name, "client")
+	file.PanicOnErr()
+}
+
+// WriteGraphQLScaffolding writes empty '.graphql' files to the GraphQL
+// directory of each client. Use these stubs to write your GraphQL queries for
+// your clients to invoke.
+func (p *Project) WriteGraphQLScaffolding() {
 	for _, client := range p.Clients {
-		if client.Kind == IOSClientKind {
-			iosClient = client
-			break
+		if dir := client.GraphQLDir(p.Name); dir != "" {
+			p.writeGraphQLDocuments(dir)
 		}
 	}
+}
 
+func (p *Project) writeGraphQLDocuments(dir string) {
 	root := filepath.Join(p.dest, p.Name)
-	dir := iosClient.GraphQLDir(p.Name)
 
 	// Write mutation files
 	for _, fn := range p.Definition.Mutations {
-		file := NewFile(strings.ToLower(fn.Name), "graphql")
+		file := p.newFile(strings.ToLower(fn.Name), "graphql")
+		file.Write(root, dir)
+		file.PanicOnErr()
+	}
+
+	// Write subscription files
+	for _, fn := range p.Definition.Subscriptions {
+		file := p.newFile(strings.ToLower(fn.Name), "graphql")
 		file.Write(root, dir)
 		file.PanicOnErr()
 	}
@@ -240,12 +596,12 @@ func (p *Project) WriteSwiftScaffoldingForGraphQL() {
 	for _, fn := range p.Definition.Queries {
 		if fn.Return.IsInterface {
 			for _, tp := range fn.Return.PossibleTypes {
-				file := NewFile("node."+strings.ToLower(tp.Name), "graphql")
+				file := p.newFile(strings.ToLower(fn.Name+"."+tp.Name), "graphql")
 				file.Write(root, dir)
 				file.PanicOnErr()
 			}
 		} else {
-			file := NewFile(strings.ToLower(fn.Name), "graphql")
+			file := p.newFile(strings.ToLower(fn.Name), "graphql")
 			file.Write(root, dir)
 			file.PanicOnErr()
 		}
@@ -262,14 +618,70 @@ func (p *Project) IOSClient() Client {
 	return Client{}
 }
 
+// AndroidClient returns the Android client.
+func (p *Project) AndroidClient() Client {
+	for _, c := range p.Clients {
+		if c.Kind == AndroidClientKind {
+			return c
+		}
+	}
+	return Client{}
+}
+
+// WebClient returns the web client.
+func (p *Project) WebClient() Client {
+	for _, c := range p.Clients {
+		if c.Kind == WebClientKind {
+			return c
+		}
+	}
+	return Client{}
+}
+
 // GraphQLDir returns the GraphQL folder that's located inside the client dir.
 func (c *Client) GraphQLDir(projectName string) string {
-	if c.Kind == IOSClientKind {
+	switch c.Kind {
+	case IOSClientKind:
 		return fmt.Sprintf("clients/ios/Sources/%sKit/GraphQL", strings.Title(projectName))
+	case AndroidClientKind:
+		return "clients/android/kit/src/main/assets/graphql"
+	case WebClientKind:
+		return "clients/www/src/graphql"
 	}
 	return ""
 }
 
+// skips reports whether a client template is left out of the client's
+// layout, e.g. the Package.swift of iOS clients without a Swift package.
+func (c Client) skips(filename string) bool {
+	if c.Kind != IOSClientKind {
+		return false
+	}
+	switch filename {
+	case clientsFolder + "/ios/Package.swift":
+		return !c.SwiftPackage
+	case clientsFolder + "/ios/project.yml":
+		return !c.XcodeGen
+	}
+	return false
+}
+
+// DataTypeNamespace returns the namespace of the datatypes the Project's
+// records are stored with e.g. com.example.app. It's derived from the domain,
+// or from the module when there's no domain.
+func (p *Project) DataTypeNamespace() string {
+	if p.Domain == "" {
+		return strings.ToLower(strings.NewReplacer("/", ".", "-", "_").Replace(p.Module))
+	}
+	return strings.ToLower(reverseDomain(p.Domain) + "." + p.Name)
+}
+
+// PackageName returns the package of the client's code, generally used for
+// Android clients e.g. com.example.app
+func (c Client) PackageName() string {
+	return strings.ToLower(c.BundleDomain + "." + c.Name)
+}
+
 func (p *Project) writeTemplates(templates TemplateFiles) {
 	for name, filename := range templates {
 		p.writeTemplate(name, filename)
@@ -279,31 +691,9 @@ func (p *Project) writeTemplates(templates TemplateFiles) {
 func (p *Project) writeTemplate(name string, filename string) {
 
 	writename := filepath.Join(p.dest, p.Name, filename)
-	writepath := filepath.Dir(writename)
 
-	// Check if file already exists
-	if _, err := os.Stat(writename); !os.IsNotExist(err) {
-		fmt.Printf("\tFile Already Exists: %s\n", filename)
-		return
-	}
-
-	// Create directories if file path doesn't exist
-	if _, err := os.Stat(writepath); os.IsNotExist(err) {
-		if err := os.MkdirAll(writepath, 0755); err != nil {
-			p.err = err
-			return
-		}
-	}
-
-	// Create file
-	file, err := os.Create(writename)
-	if err != nil {
-		p.err = err
-		return
-	}
-
-	// Read cached template data
-	data, err := FSString(false, name)
+	// Read template data from the file system it was found in
+	data, err := fs.ReadFile(p.templateFS[name], name)
 	if err != nil {
 		p.err = err
 		return
@@ -315,27 +705,77 @@ func (p *Project) writeTemplate(name string, filename string) {
 			"titlecase":                  strings.Title,
 			"uppercase":                  strings.ToUpper,
 			"lowercase":                  strings.ToLower,
-			"joinArgsForSwift":           def.JoinArgsForSwift,
+			"camelcase":                  lowerFirstLetter,
+			"joinArgsForSwift":           p.joinArgsForSwift,
+			"joinArgsForSwiftVars":       def.JoinArgsForSwiftVars,
 			"joinInterfacesForSwift":     def.JoinInterfacesForSwift,
 			"joinArgsForGraphQL":         def.JoinArgsForGraphQL,
 			"joinArgsForGraphQLVars":     def.JoinArgsForGraphQLVars,
 			"graphQLScalarToSwiftScalar": def.GraphQLScalarToSwiftScalar,
+			"isGraphQLScalar":            def.IsGraphQLScalar,
 			"swiftScalar":                def.ToSwiftScalar,
 			"excludeSwiftScalars":        def.ExcludeSwiftScalars,
+			"joinArgsForKotlin":          def.JoinArgsForKotlin,
+			"joinArgsForKotlinVars":      def.JoinArgsForKotlinVars,
+			"kotlinType":                 def.ToKotlinType,
+			"isInterfaceField":           p.isInterfaceField,
+			"joinArgsForTypeScript":      def.JoinArgsForTypeScript,
+			"typeScriptType":             def.ToTypeScriptType,
+			"graphQLDocument":            p.graphQLDocument,
+			"swiftType":                  p.swiftType,
+			"swiftNeedsCoding":           p.swiftNeedsCoding,
+			"swiftProperty":              p.swiftProperty,
+			"swiftAssign":                p.swiftAssign,
+			"swiftVariables":             p.swiftVariables,
+			"swiftResponseData":          p.swiftResponseData,
+			"swiftDocumentData":          p.swiftDocumentData,
+			"swiftCachedFields":          p.swiftCachedFields,
+			"swiftDecodeField":           p.swiftDecodeField,
+			"swiftEncodeField":           p.swiftEncodeField,
 		},
-	).Parse(data)
+	).Parse(string(data))
 	if err != nil {
 		p.err = err
 		return
 	}
-	if err := tmpl.Execute(file, p); err != nil {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, p); err != nil {
 		p.err = err
 		return
 	}
+	if err := p.output.writeFile(writename, p.templateSources[name], buf.Bytes(), false); err != nil {
+		p.err = err
+	}
+}
+
+// newFile returns a new File that's generated from the schema and written
+// with the Project's output.
+func (p *Project) newFile(name string, ext string) File {
+	file := NewFile(name, ext)
+	file.source = schemaSource
+	file.output = p.output
+	return file
+}
+
+// isInterfaceField reports whether the named field of an object is declared
+// by one of the interfaces it implements.
+func (p *Project) isInterfaceField(obj def.TypeDef, name string) bool {
+	for _, intf := range p.Definition.Interfaces {
+		if !implements(obj, intf.Name) {
+			continue
+		}
+		if _, ok := intf.Field(name); ok {
+			return true
+		}
+	}
+	return false
+}
 
-	// Close file
-	file.Close()
-	fmt.Println("\tCreated File: ", writename)
+// graphQLDocument returns the named '.graphql' document of a client as a
+// quoted string so templates can embed it. Missing documents are empty.
+func (p *Project) graphQLDocument(client Client, name string) string {
+	data, _ := ioutil.ReadFile(filepath.Join(p.dest, p.Name, client.GraphQLDir(p.Name), name+".graphql"))
+	return strconv.Quote(strings.TrimSpace(string(data)))
 }
 
 func reverseDomain(in string) string {
@@ -0,0 +1,322 @@
+package gen
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nathanborror/startapp/def"
+)
+
+const connectionSchema = `
+schema {
+  query: Query
+}
+
+type Query {
+  viewer: Account!
+  node(id: ID!): Node
+  actor(id: ID!): Actor
+  posts(first: Int, after: String, last: Int, before: String): PostConnection!
+}
+
+interface Node {
+  id: ID!
+}
+
+interface Actor implements Node {
+  id: ID!
+  name: String!
+}
+
+type Account implements Node & Actor {
+  id: ID!
+  name: String!
+  score: Int
+}
+
+type Post implements Node {
+  id: ID!
+  title: String!
+  excerpt(length: Int = 100): String!
+  comments(first: Int, after: String, last: Int, before: String): CommentConnection!
+}
+
+type Comment implements Node {
+  id: ID!
+  text: String!
+}
+
+type PageInfo {
+  hasNextPage: Boolean!
+  hasPreviousPage: Boolean!
+  startCursor: String
+  endCursor: String
+}
+
+type PostConnection {
+  edges: [PostEdge]
+  pageInfo: PageInfo!
+}
+
+type PostEdge {
+  cursor: String!
+  node: Post
+}
+
+type CommentConnection {
+  edges: [CommentEdge]
+  pageInfo: PageInfo!
+}
+
+type CommentEdge {
+  cursor: String!
+  node: Comment
+}
+`
+
+// TestGeneratedSchema generates a project whose objects have connection
+// fields, field arguments, interfaces implementing interfaces and Account
+// fields unknown to the templates, and checks the project builds and its
+// resolvers satisfy the schema.
+func TestGeneratedSchema(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping generated project build in short mode")
+	}
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go not found")
+	}
+
+	dir := t.TempDir()
+	schema := filepath.Join(dir, "schema.graphql")
+	if err := ioutil.WriteFile(schema, []byte(connectionSchema), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	p := NewProject("example", dir, "example.com")
+	p.SetTemplates(os.DirFS("../templates"))
+	p.ReadGraphQLSchema(schema)
+	p.Copy(schema)
+	p.Write()
+	if err := p.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	project := filepath.Join(dir, "example")
+	if out, err := goCommand(project, "mod", "tidy"); err != nil {
+		t.Skipf("resolving the project's modules: %v\n%s", err, out)
+	}
+	if out, err := goCommand(project, "test", "./api/"); err != nil {
+		t.Fatalf("%v\n%s", err, out)
+	}
+
+	// Only node(id:) is dispatched by type, actor(id:) is a stub.
+	stubs, err := ioutil.ReadFile(filepath.Join(project, "api", "queries.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(stubs), "func (r *rootResolver) Actor(") {
+		t.Errorf("missing Actor stub in api/queries.go:\n%s", stubs)
+	}
+}
+
+func goCommand(dir string, args ...string) ([]byte, error) {
+	cmd := exec.Command("go", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod")
+	return cmd.CombinedOutput()
+}
+
+func TestScalarWithoutCodecs(t *testing.T) {
+	dir := t.TempDir()
+	schema := filepath.Join(dir, "schema.graphql")
+	if err := ioutil.WriteFile(schema, []byte("scalar Money\n"+connectionSchema), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	p := NewProject("example", dir, "example.com")
+	p.AddScalars(map[string]def.ScalarDef{"Money": {GoType: "int64"}})
+	p.ReadGraphQLSchema(schema)
+	if p.Err() == nil {
+		t.Fatal("expected an error for a go-type without codecs")
+	}
+}
+
+func TestUnconfiguredClientsKept(t *testing.T) {
+	dir := t.TempDir()
+	schema := filepath.Join(dir, "schema.graphql")
+	if err := ioutil.WriteFile(schema, []byte(connectionSchema), 0644); err != nil {
+		t.Fatal(err)
+	}
+	write := func(web bool) {
+		p := NewProject("example", dir, "example.com")
+		p.SetTemplates(os.DirFS("../templates"))
+		if web {
+			p.AddWebClient("Example")
+		}
+		p.ReadGraphQLSchema(schema)
+		p.Write()
+		if err := p.Err(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write(true)
+	web := filepath.Join(dir, "example", "clients", "www", "src", "types.ts")
+	if _, err := os.Stat(web); err != nil {
+		t.Fatal(err)
+	}
+	write(false)
+	if _, err := os.Stat(web); err != nil {
+		t.Fatalf("web client removed: %v", err)
+	}
+}
+
+func TestSchemaCopyUpdated(t *testing.T) {
+	dir := t.TempDir()
+	schema := filepath.Join(dir, "schema.graphql")
+	write := func(data string) {
+		if err := ioutil.WriteFile(schema, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+		p := NewProject("example", dir, "example.com")
+		p.SetTemplates(os.DirFS("../templates"))
+		p.ReadGraphQLSchema(schema)
+		p.Copy(schema)
+		p.Write()
+		if err := p.Err(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write(connectionSchema)
+	updated := strings.Replace(connectionSchema, "  title: String!\n", "  title: String!\n  subtitle: String\n", 1)
+	write(updated)
+	data, err := ioutil.ReadFile(filepath.Join(dir, "example", "schema.graphql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "subtitle: String") {
+		t.Fatalf("schema copy wasn't updated:\n%s", data)
+	}
+}
+
+func TestStatusIgnoresOwnedFiles(t *testing.T) {
+	dir := t.TempDir()
+	schema := filepath.Join(dir, "schema.graphql")
+	if err := ioutil.WriteFile(schema, []byte(connectionSchema), 0644); err != nil {
+		t.Fatal(err)
+	}
+	p := NewProject("example", dir, "example.com")
+	p.SetTemplates(os.DirFS("../templates"))
+	p.AddWebClient("Example")
+	p.ReadGraphQLSchema(schema)
+	p.Write()
+	if err := p.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	root := filepath.Join(dir, "example")
+	for _, name := range []string{"go.mod", "clients/www/src/graphql/viewer.graphql"} {
+		if err := ioutil.WriteFile(filepath.Join(root, name), []byte("edited\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	manifest, err := ReadManifest(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, status := range manifest.Status(root) {
+		if status.State != StateClean {
+			t.Errorf("%s: %s", status.Filename, status.State)
+		}
+	}
+}
+
+func TestCachedFields(t *testing.T) {
+	dir := t.TempDir()
+	schema := filepath.Join(dir, "schema.graphql")
+	if err := ioutil.WriteFile(schema, []byte(connectionSchema), 0644); err != nil {
+		t.Fatal(err)
+	}
+	write := func() {
+		p := NewProject("example", dir, "example.com")
+		p.SetTemplates(os.DirFS("../templates"))
+		p.AddIOSClient("Example", "", true, false, true, false)
+		p.ReadGraphQLSchema(schema)
+		p.Write()
+		if err := p.Err(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write()
+	client := Client{Name: "Example", Kind: IOSClientKind}
+	document := filepath.Join(dir, "example", client.GraphQLDir("example"), "viewer.graphql")
+	if err := ioutil.WriteFile(document, []byte("query { viewer { __typename id score: name } }\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	write()
+
+	matches, _ := filepath.Glob(filepath.Join(dir, "example", "clients", "ios", "Sources", "*", "Remote", "RemoteCache.swift"))
+	if len(matches) != 1 {
+		t.Fatalf("RemoteCache.swift not found: %v", matches)
+	}
+	data, err := ioutil.ReadFile(matches[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Post.excerpt takes arguments and Account's score key is an alias.
+	want := `["Account": ["__typename", "id", "name"], "Post": ["__typename", "id", "title"], "Comment": ["__typename", "id", "text"]]`
+	if !strings.Contains(string(data), want) {
+		t.Errorf("cached fields aren't %s:\n%s", want, data)
+	}
+}
+
+func TestDocumentWithOperations(t *testing.T) {
+	dir := t.TempDir()
+	schema := filepath.Join(dir, "schema.graphql")
+	if err := ioutil.WriteFile(schema, []byte(connectionSchema), 0644); err != nil {
+		t.Fatal(err)
+	}
+	write := func() error {
+		p := NewProject("example", dir, "example.com")
+		p.SetTemplates(os.DirFS("../templates"))
+		p.AddIOSClient("Example", "", true, false, true, false)
+		p.ReadGraphQLSchema(schema)
+		p.Write()
+		return p.Err()
+	}
+	if err := write(); err != nil {
+		t.Fatal(err)
+	}
+
+	client := Client{Name: "Example", Kind: IOSClientKind}
+	document := filepath.Join(dir, "example", client.GraphQLDir("example"), "viewer.graphql")
+	tests := []struct {
+		name     string
+		document string
+		err      string
+	}{
+		{"one", "query { viewer { id } }\n", ""},
+		{"none", "fragment Name on Account { name }\n", ""},
+		{"two", "query A { viewer { id } }\nquery B { viewer { name } }\n", "viewer.graphql: has 2 operations, a document can only have one"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ioutil.WriteFile(document, []byte(tt.document), 0644); err != nil {
+				t.Fatal(err)
+			}
+			err := write()
+			if tt.err == "" && err != nil {
+				t.Fatal(err)
+			}
+			if tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)) {
+				t.Fatalf("error is %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package gen
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ManifestFilename is the name of the manifest written to the project root.
+const ManifestFilename = ".startapp-manifest.json"
+
+// Sources of generated files that aren't rendered from a template.
+const schemaSource = "schema"
+
+// Manifest records every file written by the generator so the next run can
+// detect user modifications and remove files that are no longer generated.
+type Manifest struct {
+	Files map[string]ManifestEntry `json:"files"` // Keyed by path relative to the project root
+}
+
+// ManifestEntry describes a generated file. Files written without the
+// generated marker, such as go.mod and the GraphQL document stubs, belong to
+// the user once they're created. Their hash is the created content.
+type ManifestEntry struct {
+	Hash   string `json:"hash"`            // Hash of the content outside of regions
+	Source string `json:"source"`          // Template the file was rendered from or 'schema'
+	Owned  bool   `json:"owned,omitempty"` // User owned, so edits aren't drift
+}
+
+// File states reported by Manifest.Status.
+const (
+	StateClean    = "clean"
+	StateModified = "modified"
+	StateMissing  = "missing"
+)
+
+// FileStatus is the state of a generated file on disk.
+type FileStatus struct {
+	Filename string
+	Source   string
+	State    string
+}
+
+// NewManifest returns an empty Manifest.
+func NewManifest() *Manifest {
+	return &Manifest{Files: make(map[string]ManifestEntry)}
+}
+
+// ReadManifest reads the manifest from the given project root. Projects
+// without a manifest return an empty one.
+func ReadManifest(root string) (*Manifest, error) {
+	data, err := ioutil.ReadFile(filepath.Join(root, ManifestFilename))
+	if os.IsNotExist(err) {
+		return NewManifest(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	m := NewManifest()
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("Invalid manifest: %v", err)
+	}
+	if m.Files == nil {
+		m.Files = make(map[string]ManifestEntry)
+	}
+	return m, nil
+}
+
+// Write writes the manifest to the given project root.
+func (m *Manifest) Write(root string) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(root, ManifestFilename), append(data, '\n'), 0644)
+}
+
+// Filenames returns the sorted filenames in the manifest.
+func (m *Manifest) Filenames() []string {
+	var out []string
+	for name := range m.Files {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Status compares every generated file in the manifest with the file on disk,
+// user owned files are left out.
+func (m *Manifest) Status(root string) []FileStatus {
+	var out []FileStatus
+	for _, name := range m.Filenames() {
+		entry := m.Files[name]
+		if entry.Owned {
+			continue
+		}
+		status := FileStatus{Filename: name, Source: entry.Source, State: StateClean}
+		data, err := ioutil.ReadFile(filepath.Join(root, name))
+		switch {
+		case err != nil:
+			status.State = StateMissing
+		case hashContent(data) != entry.Hash:
+			status.State = StateModified
+		}
+		out = append(out, status)
+	}
+	return out
+}
+
+// hashContent hashes data with the content of its regions removed so editing
+// regions doesn't count as modifying a generated file.
+func hashContent(data []byte) string {
+	var (
+		out    []string
+		name   string
+		inside bool
+	)
+	for _, line := range strings.SplitAfter(string(data), "\n") {
+		switch {
+		case !inside && regionName(line, regionBegin) != "":
+			name = regionName(line, regionBegin)
+			inside = true
+			out = append(out, line)
+		case inside && regionName(line, regionEnd) == name:
+			inside = false
+			out = append(out, line)
+		case !inside:
+			out = append(out, line)
+		}
+	}
+	return fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(strings.Join(out, ""))))
+}
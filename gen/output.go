@@ -0,0 +1,314 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// OutputMode determines what happens to rendered project files.
+type OutputMode int
+
+const (
+	WriteMode  OutputMode = iota // Write files to disk
+	DryRunMode                   // List the files that would be written
+	DiffMode                     // Print unified diffs against the files on disk
+)
+
+// Actions taken when a rendered file is written.
+const (
+	actionCreate    = "create"
+	actionUpdate    = "update"
+	actionUnchanged = "unchanged"
+	actionSkip      = "skip"
+	actionRemove    = "remove"
+)
+
+// Output writes rendered files to disk or, in dry-run and diff modes, reports
+// what writing them would change without touching the working tree. Files
+// written to the project root are recorded in its manifest.
+type Output struct {
+	mode     OutputMode
+	root     string    // Project root containing the manifest
+	previous *Manifest // Manifest of the last run, read on first write
+	manifest *Manifest // Manifest of this run
+	kept     []string  // Directories whose files of the last run are kept
+	counts   map[string]int
+}
+
+// NewOutput returns a new Output for the project in root.
+func NewOutput(root string, mode OutputMode) *Output {
+	return &Output{
+		mode:     mode,
+		root:     root,
+		manifest: NewManifest(),
+		counts:   make(map[string]int),
+	}
+}
+
+// change describes what writing a rendered file does to the file on disk.
+type change struct {
+	filename string
+	action   string
+	existing []byte
+	data     []byte            // Rendered data with regions merged
+	regions  map[string]string // Regions of the existing file
+	dropped  []string          // Regions that no longer exist in data
+	modified bool              // Existing file was edited outside its regions
+}
+
+// planChange compares rendered data with the file on disk. Existing generated
+// files keep the content of their regions and user owned files are skipped,
+// unless overwrite is set for files that are copied into the project.
+func planChange(filename string, data []byte, overwrite bool) (change, error) {
+	c := change{filename: filename, data: data}
+	existing, err := ioutil.ReadFile(filename)
+	if os.IsNotExist(err) {
+		c.action = actionCreate
+		return c, nil
+	}
+	if err != nil {
+		return c, err
+	}
+	c.existing = existing
+	if !isGenerated(existing) && !overwrite {
+		c.action = actionSkip
+		return c, nil
+	}
+
+	c.regions = parseRegions(existing)
+	c.data, c.dropped = mergeRegions(data, c.regions)
+	sort.Strings(c.dropped)
+	if bytes.Equal(c.data, existing) {
+		c.action = actionUnchanged
+	} else {
+		c.action = actionUpdate
+	}
+	return c, nil
+}
+
+// writeFile writes data to filename or reports the change depending on the
+// output mode. Source is the template the data was rendered from, overwrite
+// replaces the file even if it's user owned.
+func (o *Output) writeFile(filename string, source string, data []byte, overwrite bool) error {
+	if err := o.readManifest(); err != nil {
+		return err
+	}
+	c, err := planChange(filename, data, overwrite)
+	if err != nil {
+		return err
+	}
+	o.counts[c.action]++
+	o.record(&c, source)
+
+	switch o.mode {
+	case DryRunMode:
+		o.printChange(c)
+		return nil
+	case DiffMode:
+		if c.action == actionCreate || c.action == actionUpdate {
+			fmt.Print(unifiedDiff(c.filename, c.existing, c.data))
+		}
+		return nil
+	}
+	return c.write()
+}
+
+// readManifest reads the manifest of the last run once.
+func (o *Output) readManifest() error {
+	if o.previous != nil {
+		return nil
+	}
+	previous, err := ReadManifest(o.root)
+	if err != nil {
+		return err
+	}
+	o.previous = previous
+	return nil
+}
+
+// record adds the change to the manifest and checks whether the existing file
+// was modified since the last run. Files outside the project root aren't
+// recorded.
+func (o *Output) record(c *change, source string) {
+	name, err := filepath.Rel(o.root, c.filename)
+	if err != nil || strings.HasPrefix(name, "..") {
+		return
+	}
+	name = filepath.ToSlash(name)
+	previous, ok := o.previous.Files[name]
+	owned := !isGenerated(c.data)
+	switch c.action {
+	case actionSkip:
+		// User owned files stay recorded if they were generated before.
+		if ok {
+			previous.Owned = owned
+			o.manifest.Files[name] = previous
+		}
+	default:
+		if ok && !owned && c.existing != nil && hashContent(c.existing) != previous.Hash {
+			c.modified = true
+		}
+		o.manifest.Files[name] = ManifestEntry{Hash: hashContent(c.data), Source: source, Owned: owned}
+	}
+}
+
+// Keep keeps the files the last run generated in dir, a slash separated path
+// relative to the project root, when they aren't generated by this run. It's
+// used for the outputs of clients that aren't configured in this run.
+func (o *Output) Keep(dir string) {
+	o.kept = append(o.kept, strings.TrimSuffix(dir, "/")+"/")
+}
+
+// Finish removes files that were generated by the last run but not this one,
+// unless they were modified or kept, and writes the manifest. Dry-run and diff
+// modes only report what would be removed.
+func (o *Output) Finish() error {
+	if err := o.readManifest(); err != nil {
+		return err
+	}
+	for _, name := range o.previous.Filenames() {
+		if _, ok := o.manifest.Files[name]; ok {
+			continue
+		}
+		if o.isKept(name) {
+			o.manifest.Files[name] = o.previous.Files[name]
+			continue
+		}
+		if err := o.removeOrphan(name, o.previous.Files[name]); err != nil {
+			return err
+		}
+	}
+	o.printSummary()
+	if o.mode != WriteMode {
+		return nil
+	}
+	return o.manifest.Write(o.root)
+}
+
+func (o *Output) isKept(name string) bool {
+	for _, dir := range o.kept {
+		if strings.HasPrefix(name, dir) {
+			return true
+		}
+	}
+	return false
+}
+
+func (o *Output) removeOrphan(name string, entry ManifestEntry) error {
+	filename := filepath.Join(o.root, filepath.FromSlash(name))
+	existing, err := ioutil.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if hashContent(existing) != entry.Hash {
+		// Keep modified files recorded so they're reported until resolved.
+		o.manifest.Files[name] = entry
+		fmt.Printf("\tKeeping Modified File: %s\n", filename)
+		return nil
+	}
+	o.counts[actionRemove]++
+
+	c := change{filename: filename, action: actionRemove, existing: existing}
+	if isGenerated(existing) {
+		c.regions = parseRegions(existing)
+		for name := range c.regions {
+			c.dropped = append(c.dropped, name)
+		}
+		sort.Strings(c.dropped)
+	}
+	switch o.mode {
+	case DryRunMode:
+		o.printChange(c)
+		return nil
+	case DiffMode:
+		fmt.Print(unifiedDiff(c.filename, c.existing, nil))
+		return nil
+	}
+	if err := c.writeDroppedRegions(); err != nil {
+		return err
+	}
+	if err := os.Remove(filename); err != nil {
+		return err
+	}
+	fmt.Printf("\tRemoved File: %s\n", filename)
+	return nil
+}
+
+// printSummary prints the number of files in each action when files aren't
+// being written.
+func (o *Output) printSummary() {
+	if o.mode == WriteMode {
+		return
+	}
+	fmt.Printf("%d to create, %d to update, %d unchanged, %d skipped, %d to remove\n",
+		o.counts[actionCreate], o.counts[actionUpdate], o.counts[actionUnchanged], o.counts[actionSkip], o.counts[actionRemove])
+}
+
+func (o *Output) printChange(c change) {
+	switch c.action {
+	case actionCreate:
+		fmt.Printf("\tWould Create: %s\n", c.filename)
+	case actionUpdate:
+		fmt.Printf("\tWould Update: %s\n", c.filename)
+	case actionUnchanged:
+		fmt.Printf("\tUnchanged: %s\n", c.filename)
+	case actionSkip:
+		fmt.Printf("\tWould Skip: %s\n", c.filename)
+	case actionRemove:
+		fmt.Printf("\tWould Remove: %s\n", c.filename)
+	}
+	if c.modified {
+		fmt.Printf("\tWould Overwrite Modified File: %s\n", c.filename)
+	}
+	for _, name := range c.dropped {
+		fmt.Printf("\tWould Drop Region: %s in %s\n", name, c.filename)
+	}
+}
+
+func (c change) write() error {
+	switch c.action {
+	case actionSkip:
+		fmt.Printf("\tFile Already Exists: %s\n", c.filename)
+		return nil
+	case actionUnchanged:
+		fmt.Printf("\tFile Unchanged: %s\n", c.filename)
+		return c.writeDroppedRegions()
+	}
+	if err := os.MkdirAll(filepath.Dir(c.filename), 0755); err != nil {
+		return err
+	}
+	if c.modified {
+		fmt.Printf("\tOverwriting Modified File: %s\n", c.filename)
+	}
+	if err := ioutil.WriteFile(c.filename, c.data, 0644); err != nil {
+		return err
+	}
+	if c.action == actionCreate {
+		fmt.Printf("\tCreated File: %s\n", c.filename)
+		return nil
+	}
+	fmt.Printf("\tUpdated File: %s\n", c.filename)
+	return c.writeDroppedRegions()
+}
+
+// writeDroppedRegions saves regions that no longer exist in a generated file
+// next to it so user code isn't lost when the schema changes.
+func (c change) writeDroppedRegions() error {
+	if len(c.dropped) == 0 {
+		return nil
+	}
+	var out bytes.Buffer
+	for _, name := range c.dropped {
+		fmt.Fprintf(&out, "%s %s\n%s%s %s\n\n", regionBegin, name, c.regions[name], regionEnd, name)
+		fmt.Printf("\tDropped Region: %s in %s\n", name, c.filename)
+	}
+	return ioutil.WriteFile(c.filename+".dropped", out.Bytes(), 0644)
+}
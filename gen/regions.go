@@ -0,0 +1,121 @@
+package gen
+
+import (
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"strings"
+)
+
+// Generated files start with a header containing generatedMarker and are
+// rewritten every time the project is generated. Content between a
+// regionBegin and regionEnd line is kept from the existing file so generated
+// files can contain user code. Files without the marker belong to the user and
+// are never overwritten, remove the header from a generated file to keep it.
+const (
+	generatedMarker = "Code generated by startapp"
+	doNotEditHeader = "// Code generated by startapp. DO NOT EDIT."
+	regionsHeader   = "// Code generated by startapp. Edit only inside the regions that are marked\n// by startapp:begin and startapp:end, the rest of the file is rewritten when\n// the project is generated."
+	regionBegin     = "startapp:begin"
+	regionEnd       = "startapp:end"
+)
+
+// markerLines is the number of lines at the top of a file that are searched
+// for the generated marker.
+const markerLines = 10
+
+// isGenerated reports whether data starts with the generated marker.
+func isGenerated(data []byte) bool {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for i := 0; i < markerLines && scanner.Scan(); i++ {
+		if strings.Contains(scanner.Text(), generatedMarker) {
+			return true
+		}
+	}
+	return false
+}
+
+// readRegions returns the content of each region in a generated file keyed by
+// the region's name. User owned and missing files have no regions.
+func readRegions(filename string) map[string]string {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil || !isGenerated(data) {
+		return nil
+	}
+	return parseRegions(data)
+}
+
+// parseRegions returns the content of each region in data keyed by the
+// region's name: `// startapp:begin <name>`.
+func parseRegions(data []byte) map[string]string {
+	regions := make(map[string]string)
+	var (
+		name    string
+		content []string
+		inside  bool
+	)
+	for _, line := range strings.SplitAfter(string(data), "\n") {
+		switch {
+		case !inside && regionName(line, regionBegin) != "":
+			name = regionName(line, regionBegin)
+			content = nil
+			inside = true
+		case inside && regionName(line, regionEnd) == name:
+			regions[name] = strings.Join(content, "")
+			inside = false
+		case inside:
+			content = append(content, line)
+		}
+	}
+	return regions
+}
+
+// mergeRegions replaces the content of each region in data with the content
+// of the region with the same name in regions. The names of regions that no
+// longer exist in data are returned.
+func mergeRegions(data []byte, regions map[string]string) ([]byte, []string) {
+	var (
+		out    bytes.Buffer
+		name   string
+		inside bool
+		seen   = make(map[string]bool)
+	)
+	for _, line := range strings.SplitAfter(string(data), "\n") {
+		switch {
+		case !inside && regionName(line, regionBegin) != "":
+			name = regionName(line, regionBegin)
+			inside = true
+			out.WriteString(line)
+		case inside && regionName(line, regionEnd) == name:
+			if content, ok := regions[name]; ok {
+				out.WriteString(content)
+			}
+			seen[name] = true
+			inside = false
+			out.WriteString(line)
+		case inside:
+			if _, ok := regions[name]; !ok {
+				out.WriteString(line)
+			}
+		default:
+			out.WriteString(line)
+		}
+	}
+	var dropped []string
+	for name := range regions {
+		if !seen[name] {
+			dropped = append(dropped, name)
+		}
+	}
+	return out.Bytes(), dropped
+}
+
+// regionName returns the name of a region marker line or an empty string.
+// Markers follow a comment token: `// startapp:begin <name>`.
+func regionName(line string, marker string) string {
+	fields := strings.Fields(line)
+	if len(fields) < 3 || fields[1] != marker {
+		return ""
+	}
+	return fields[2]
+}
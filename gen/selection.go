@@ -0,0 +1,464 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/nathanborror/startapp/def"
+	"github.com/nathanborror/startapp/graphql"
+	"github.com/nathanborror/startapp/graphql/common"
+)
+
+// swiftSelection is a Swift type shaped by a selection set. The types of
+// Fields name the Nested types, the enums of interfaces and unions have a
+// case for each possible type instead.
+type swiftSelection struct {
+	Name     string
+	TypeName string // GraphQL type the selection set applies to
+	Fields   []def.FieldDef
+	Nested   []swiftSelection
+	Cases    []swiftSelection
+	IsEnum   bool
+}
+
+// collectedField is a field of a selection set merged with the fields of the
+// same response key selected by fragments. It's conditional when every
+// selection of it depends on an @include or @skip directive.
+type collectedField struct {
+	key         string
+	name        string
+	selections  []graphql.Selection
+	conditional bool
+}
+
+// swiftSelectionWriter builds the response types of a document's operation.
+// Mutation responses and optimistic data are merged into the normalized cache,
+// so their objects of types implementing Node must select __typename and id.
+type swiftSelectionWriter struct {
+	p          *Project
+	doc        *graphql.Document
+	normalized bool
+	aliased    map[string]map[string]bool // Response keys of aliased fields by type, when set
+}
+
+// swiftCachedType is a type implementing Node and the fields of its objects
+// the normalized cache stores.
+type swiftCachedType struct {
+	Name   string
+	Fields []string
+}
+
+// swiftDocumentData returns the Data struct of a response shaped by the
+// selection set of the iOS client's named '.graphql' document. It's empty when
+// the document has no operation, responses then decode the schema's types.
+// Documents are sent whole, so they can't have more than one operation.
+func (p *Project) swiftDocumentData(name string) (string, error) {
+	doc, err := p.swiftDocument(name)
+	if err != nil || doc == nil {
+		return "", err
+	}
+	w := swiftSelectionWriter{p: p, doc: doc, normalized: doc.Operations[0].Type == "mutation"}
+	out, err := w.operation(doc.Operations[0])
+	if err != nil {
+		return "", fmt.Errorf("%s.graphql: %v", name, err)
+	}
+	var buf bytes.Buffer
+	buf.WriteString("\n")
+	w.write(&buf, out, 2)
+	return buf.String(), nil
+}
+
+// swiftDocument parses the iOS client's named '.graphql' document, it's nil
+// when the document is missing or has no operation.
+func (p *Project) swiftDocument(name string) (*graphql.Document, error) {
+	client := p.IOSClient()
+	filename := name + ".graphql"
+	data, err := ioutil.ReadFile(filepath.Join(p.dest, p.Name, client.GraphQLDir(p.Name), filename))
+	if err != nil {
+		return nil, nil
+	}
+	doc, err := graphql.ParseDocument(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+	if len(doc.Operations) == 0 {
+		return nil, nil
+	}
+	if len(doc.Operations) > 1 {
+		return nil, fmt.Errorf("%s: has %d operations, a document can only have one", filename, len(doc.Operations))
+	}
+	return doc, nil
+}
+
+// swiftCachedFields returns the fields the normalized cache stores for each
+// type implementing Node. Responses only carry response keys, so fields taking
+// arguments and the keys any document aliases are left out, the cache would
+// mix up their values otherwise.
+func (p *Project) swiftCachedFields() ([]swiftCachedType, error) {
+	client := p.IOSClient()
+	aliased := map[string]map[string]bool{}
+	filenames, _ := filepath.Glob(filepath.Join(p.dest, p.Name, client.GraphQLDir(p.Name), "*.graphql"))
+	for _, filename := range filenames {
+		doc, err := p.swiftDocument(strings.TrimSuffix(filepath.Base(filename), ".graphql"))
+		if err != nil {
+			return nil, err
+		}
+		if doc == nil {
+			continue
+		}
+		w := swiftSelectionWriter{p: p, doc: doc, aliased: aliased}
+		if _, err := w.operation(doc.Operations[0]); err != nil {
+			return nil, fmt.Errorf("%s: %v", filepath.Base(filename), err)
+		}
+	}
+	var out []swiftCachedType
+	for _, t := range p.Definition.Objects {
+		if !implements(t, "Node") {
+			continue
+		}
+		cached := swiftCachedType{Name: t.Name, Fields: []string{"__typename"}}
+		for _, field := range t.Fields {
+			if len(field.Arguments) == 0 && !aliased[t.Name][field.Name] {
+				cached.Fields = append(cached.Fields, field.Name)
+			}
+		}
+		out = append(out, cached)
+	}
+	return out, nil
+}
+
+// operation returns the Data struct of an operation, the root fields are
+// optional since errors null them.
+func (w swiftSelectionWriter) operation(op *graphql.Operation) (swiftSelection, error) {
+	var roots []def.FuncDef
+	switch op.Type {
+	case "query":
+		roots = w.p.Definition.Queries
+	case "mutation":
+		roots = w.p.Definition.Mutations
+	case "subscription":
+		roots = w.p.Definition.Subscriptions
+	}
+	out := swiftSelection{Name: "Data", TypeName: strings.Title(op.Type)}
+	fields, err := w.collect(op.Selections, out.TypeName, nil, false)
+	if err != nil {
+		return out, err
+	}
+	for _, c := range fields {
+		t, ok := rootType(roots, c.name)
+		if !ok && c.name != "__typename" {
+			return out, fmt.Errorf("unknown %s %q", op.Type, c.name)
+		}
+		t.IsOptional = true
+		if err := w.add(&out, c, t); err != nil {
+			return out, err
+		}
+	}
+	return out, nil
+}
+
+// selection returns the type of a selection set on the named type. Values of
+// interfaces and unions are decoded by their __typename, so it must be
+// selected for every possible type.
+func (w swiftSelectionWriter) selection(name string, t def.TypeDef, selections []graphql.Selection) (swiftSelection, error) {
+	if !t.IsInterface && !t.IsUnion {
+		return w.object(name, t.Name, selections)
+	}
+	out := swiftSelection{Name: name, TypeName: t.Name, IsEnum: true}
+	for _, pt := range w.possibleTypes(t.Name) {
+		c, err := w.object(pt.Name, pt.Name, selections)
+		if err != nil {
+			return out, err
+		}
+		if !selects(c, "__typename") {
+			return out, fmt.Errorf("selection of %s %q needs __typename to decode %s", t.Name, lowerFirstLetter(name), pt.Name)
+		}
+		out.Cases = append(out.Cases, c)
+	}
+	return out, nil
+}
+
+// selects reports whether a selection always has the field of a response key.
+func selects(s swiftSelection, key string) bool {
+	for _, field := range s.Fields {
+		if field.Name == key && !field.Type.IsOptional {
+			return true
+		}
+	}
+	return false
+}
+
+func (w swiftSelectionWriter) object(name, typeName string, selections []graphql.Selection) (swiftSelection, error) {
+	out := swiftSelection{Name: name, TypeName: typeName}
+	fields, err := w.collect(selections, typeName, nil, false)
+	if err != nil {
+		return out, err
+	}
+	for _, c := range fields {
+		if w.aliased != nil && c.key != c.name {
+			if w.aliased[typeName] == nil {
+				w.aliased[typeName] = map[string]bool{}
+			}
+			w.aliased[typeName][c.key] = true
+		}
+		var t def.TypeDef
+		if c.name != "__typename" {
+			field, ok := w.field(typeName, c.name)
+			if !ok {
+				return out, fmt.Errorf("unknown field %q on %s", c.name, typeName)
+			}
+			t = field.Type
+		}
+		if err := w.add(&out, c, t); err != nil {
+			return out, err
+		}
+	}
+	if w.normalized && w.isNode(typeName) && !(selects(out, "__typename") && selects(out, "id")) {
+		return out, fmt.Errorf("selection of %s %q needs __typename and id to be cached", typeName, lowerFirstLetter(name))
+	}
+	return out, nil
+}
+
+func (w swiftSelectionWriter) isNode(typeName string) bool {
+	t, ok := w.p.Definition.Object(typeName)
+	return ok && implements(t, "Node")
+}
+
+// add adds a selected field to a struct, fields of objects, interfaces and
+// unions are typed by a nested type named after the field. Conditional fields
+// are optional since they're missing from responses that leave them out.
+func (w swiftSelectionWriter) add(s *swiftSelection, c collectedField, t def.TypeDef) error {
+	if c.name == "__typename" {
+		t = def.TypeDef{Name: "String", IsScalar: true, IsOptional: c.conditional}
+		s.Fields = append(s.Fields, def.FieldDef{Name: c.key, Type: t})
+		return nil
+	}
+	if c.conditional {
+		t.IsOptional = true
+	}
+	named := t
+	for named.IsList && named.OfType != nil {
+		named = *named.OfType
+	}
+	if named.IsScalar || named.IsEnum {
+		if len(c.selections) > 0 {
+			return fmt.Errorf("field %q of type %s can't have a selection set", c.key, named.Name)
+		}
+		s.Fields = append(s.Fields, def.FieldDef{Name: c.key, Type: t})
+		return nil
+	}
+	if len(c.selections) == 0 {
+		return fmt.Errorf("field %q of type %s needs a selection set", c.key, named.Name)
+	}
+	nested, err := w.selection(strings.Title(c.key), named, c.selections)
+	if err != nil {
+		return err
+	}
+	s.Fields = append(s.Fields, def.FieldDef{Name: c.key, Type: swiftRenamed(t, nested.Name)})
+	s.Nested = append(s.Nested, nested)
+	return nil
+}
+
+// collect returns the fields selected on a type in order, including the
+// fields of fragments that apply to it. Fields of the same response key are
+// merged. Selections are conditional when they, or the fragments they're
+// in, have an @include or @skip directive.
+func (w swiftSelectionWriter) collect(selections []graphql.Selection, typeName string, out []collectedField, conditional bool) ([]collectedField, error) {
+	var err error
+	for _, sel := range selections {
+		switch sel := sel.(type) {
+		case *graphql.SelectedField:
+			cond := conditional || isConditional(sel.Directives)
+			merged := false
+			for i := range out {
+				if out[i].key == sel.Key() {
+					out[i].selections = append(out[i].selections, sel.Selections...)
+					out[i].conditional = out[i].conditional && cond
+					merged = true
+				}
+			}
+			if !merged {
+				selections := append([]graphql.Selection(nil), sel.Selections...)
+				out = append(out, collectedField{key: sel.Key(), name: sel.Name, selections: selections, conditional: cond})
+			}
+		case *graphql.InlineFragment:
+			if w.applies(sel.On, typeName) {
+				cond := conditional || isConditional(sel.Directives)
+				if out, err = w.collect(sel.Selections, typeName, out, cond); err != nil {
+					return nil, err
+				}
+			}
+		case *graphql.FragmentSpread:
+			frag := w.doc.Fragment(sel.Name)
+			if frag == nil {
+				return nil, fmt.Errorf("unknown fragment %q", sel.Name)
+			}
+			if w.applies(frag.On, typeName) {
+				cond := conditional || isConditional(sel.Directives)
+				if out, err = w.collect(frag.Selections, typeName, out, cond); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return out, nil
+}
+
+// isConditional reports whether directives can leave a selection out of a
+// response.
+func isConditional(directives common.DirectiveList) bool {
+	return directives.Get("include") != nil || directives.Get("skip") != nil
+}
+
+// applies reports whether a fragment's type condition matches values of the
+// named type.
+func (w swiftSelectionWriter) applies(on, typeName string) bool {
+	if on == "" || on == typeName {
+		return true
+	}
+	for _, pt := range w.possibleTypes(on) {
+		if pt.Name == typeName {
+			return true
+		}
+	}
+	return false
+}
+
+func (w swiftSelectionWriter) possibleTypes(name string) []def.TypeDef {
+	for _, t := range append(append([]def.TypeDef{}, w.p.Definition.Interfaces...), w.p.Definition.Unions...) {
+		if t.Name == name {
+			return t.PossibleTypes
+		}
+	}
+	return nil
+}
+
+func (w swiftSelectionWriter) field(typeName, name string) (def.FieldDef, bool) {
+	for _, t := range append(append([]def.TypeDef{}, w.p.Definition.Objects...), w.p.Definition.Interfaces...) {
+		if t.Name == typeName {
+			return t.Field(name)
+		}
+	}
+	return def.FieldDef{}, false
+}
+
+// write writes a selection's Swift type indented by the given number of
+// levels. Selection types are never boxed, so coding uses an unnamed owner.
+func (w swiftSelectionWriter) write(buf *bytes.Buffer, s swiftSelection, level int) {
+	indent := strings.Repeat("    ", level)
+	printf := func(format string, args ...interface{}) {
+		if format == "" {
+			buf.WriteString("\n")
+			return
+		}
+		fmt.Fprintf(buf, indent+format+"\n", args...)
+	}
+	if s.IsEnum {
+		printf("enum %s: Codable {", s.Name)
+		for _, c := range s.Cases {
+			printf("    case %s(%s)", lowerFirstLetter(c.Name), c.Name)
+		}
+		for _, c := range s.Cases {
+			printf("")
+			w.write(buf, c, level+1)
+		}
+		printf("")
+		printf("    private enum CodingKeys: String, CodingKey {")
+		printf("        case __typename")
+		printf("    }")
+		printf("")
+		printf("    init(from decoder: Decoder) throws {")
+		printf("        let container = try decoder.container(keyedBy: CodingKeys.self)")
+		printf("        let typename = try container.decode(String.self, forKey: .__typename)")
+		printf("        switch typename {")
+		for _, c := range s.Cases {
+			printf("        case \"%s\":", c.TypeName)
+			printf("            self = .%s(try %s(from: decoder))", lowerFirstLetter(c.Name), c.Name)
+		}
+		printf("        default:")
+		printf("            throw DecodingError.dataCorruptedError(forKey: .__typename, in: container, debugDescription: \"Unknown %s type: \\(typename)\")", s.TypeName)
+		printf("        }")
+		printf("    }")
+		printf("")
+		printf("    func encode(to encoder: Encoder) throws {")
+		printf("        switch self {")
+		for _, c := range s.Cases {
+			printf("        case .%s(let value):", lowerFirstLetter(c.Name))
+			printf("            try value.encode(to: encoder)")
+		}
+		printf("        }")
+		printf("    }")
+		printf("}")
+		return
+	}
+
+	owner := def.TypeDef{Fields: s.Fields}
+	printf("struct %s: Codable {", s.Name)
+	for _, field := range s.Fields {
+		printf("    let %s: %s", field.Name, w.p.swiftType(field.Type))
+	}
+	var params []string
+	for _, field := range s.Fields {
+		param := fmt.Sprintf("%s: %s", field.Name, w.p.swiftType(field.Type))
+		if field.Type.IsOptional {
+			param += " = nil"
+		}
+		params = append(params, param)
+	}
+	printf("")
+	printf("    init(%s) {", strings.Join(params, ", "))
+	for _, field := range s.Fields {
+		printf("        self.%[1]s = %[1]s", field.Name)
+	}
+	printf("    }")
+	for _, nested := range s.Nested {
+		printf("")
+		w.write(buf, nested, level+1)
+	}
+	if w.p.swiftNeedsCoding(owner) {
+		printf("")
+		printf("    private enum CodingKeys: String, CodingKey {")
+		for _, field := range s.Fields {
+			printf("        case %s", field.Name)
+		}
+		printf("    }")
+		printf("")
+		printf("    init(from decoder: Decoder) throws {")
+		printf("        let container = try decoder.container(keyedBy: CodingKeys.self)")
+		for _, field := range s.Fields {
+			printf("        %s", w.p.swiftDecodeField(owner, field))
+		}
+		printf("    }")
+		printf("")
+		printf("    func encode(to encoder: Encoder) throws {")
+		printf("        var container = encoder.container(keyedBy: CodingKeys.self)")
+		for _, field := range s.Fields {
+			printf("        %s", w.p.swiftEncodeField(field))
+		}
+		printf("    }")
+	}
+	printf("}")
+}
+
+// swiftRenamed returns a type with its named type replaced by a selection's
+// Swift type, keeping lists and optionality.
+func swiftRenamed(in def.TypeDef, name string) def.TypeDef {
+	if in.IsList && in.OfType != nil {
+		elem := swiftRenamed(*in.OfType, name)
+		in.OfType = &elem
+		return in
+	}
+	return def.TypeDef{Name: name, IsOptional: in.IsOptional}
+}
+
+func rootType(roots []def.FuncDef, name string) (def.TypeDef, bool) {
+	for _, fn := range roots {
+		if fn.Name == name {
+			return fn.Return, true
+		}
+	}
+	return def.TypeDef{}, false
+}
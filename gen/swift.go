@@ -0,0 +1,243 @@
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nathanborror/startapp/def"
+)
+
+// Swift models hold custom scalars as their Swift type e.g. Foundation.Date,
+// they're decoded and encoded through the scalar's Remote wrapper so its
+// codec is used. Models with such fields implement Codable themselves.
+
+// swiftType returns the Swift type of a model property for a GraphQL type.
+// Interfaces are held by their Any<Interface> enum.
+func (p *Project) swiftType(in def.TypeDef) string {
+	out := p.swiftValueType(in)
+	if in.IsOptional {
+		out += "?"
+	}
+	return out
+}
+
+func (p *Project) swiftValueType(in def.TypeDef) string {
+	if in.IsList && in.OfType != nil {
+		return fmt.Sprintf("[%s]", p.swiftType(*in.OfType))
+	}
+	if in.IsInterface {
+		return "Any" + in.Name
+	}
+	if in.IsScalar {
+		if s, ok := p.swiftScalarDef(in.Name); ok {
+			return s.SwiftType
+		}
+		return def.GraphQLScalarToSwiftScalar(in)
+	}
+	return in.Name
+}
+
+// swiftWireType returns the Codable type a value is decoded as, custom
+// scalars are replaced by their Remote wrapper. Optionality of the type itself
+// is left to callers.
+func (p *Project) swiftWireType(in def.TypeDef) string {
+	if in.IsList && in.OfType != nil {
+		elem := p.swiftWireType(*in.OfType)
+		if in.OfType.IsOptional {
+			elem += "?"
+		}
+		return fmt.Sprintf("[%s]", elem)
+	}
+	if _, ok := p.swiftScalarDef(in.Name); ok && in.IsScalar {
+		return "Remote." + in.Name
+	}
+	return p.swiftValueType(in)
+}
+
+// swiftNeedsCoding reports whether a model has fields holding custom scalars
+// or boxed fields and needs to implement Codable itself.
+func (p *Project) swiftNeedsCoding(t def.TypeDef) bool {
+	for _, field := range t.Fields {
+		if p.swiftHasScalar(field.Type) || p.swiftIsBoxed(t, field) {
+			return true
+		}
+	}
+	return false
+}
+
+// swiftIsBoxed reports whether a field refers back to the model that declares
+// it through other fields. Swift structs can't contain themselves so the
+// field is stored in an Indirect box.
+func (p *Project) swiftIsBoxed(owner def.TypeDef, field def.FieldDef) bool {
+	return p.swiftReaches(field.Type, owner.Name, make(map[string]bool))
+}
+
+func (p *Project) swiftReaches(in def.TypeDef, target string, seen map[string]bool) bool {
+	if in.IsList || in.IsScalar || in.IsEnum || in.IsInterface || in.IsUnion {
+		return false
+	}
+	if in.Name == target {
+		return true
+	}
+	if seen[in.Name] {
+		return false
+	}
+	seen[in.Name] = true
+	t, ok := p.swiftModel(in.Name)
+	if !ok {
+		return false
+	}
+	for _, field := range t.Fields {
+		if p.swiftReaches(field.Type, target, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+// swiftProperty returns the declaration of a model property, boxed fields
+// are stored in a private property behind a computed one.
+func (p *Project) swiftProperty(owner def.TypeDef, field def.FieldDef) string {
+	typ := p.swiftType(field.Type)
+	if !p.swiftIsBoxed(owner, field) {
+		return fmt.Sprintf("public var %s: %s", field.Name, typ)
+	}
+	value, box := ".value", fmt.Sprintf("Indirect<%s>", p.swiftValueType(field.Type))
+	if field.Type.IsOptional {
+		value, box = "?.value", box+"?"
+	}
+	return fmt.Sprintf("public var %[1]s: %[2]s {\n"+
+		"        get { return _%[1]s%[3]s }\n"+
+		"        set { _%[1]s = %[4]s }\n"+
+		"    }\n"+
+		"    private var _%[1]s: %[5]s", field.Name, typ, value, p.swiftBox(field.Type, "newValue"), box)
+}
+
+// swiftAssign returns the statement that sets a model property to the
+// initializer argument of the same name.
+func (p *Project) swiftAssign(owner def.TypeDef, field def.FieldDef) string {
+	if !p.swiftIsBoxed(owner, field) {
+		return fmt.Sprintf("self.%[1]s = %[1]s", field.Name)
+	}
+	return fmt.Sprintf("self._%s = %s", field.Name, p.swiftBox(field.Type, field.Name))
+}
+
+func (p *Project) swiftBox(in def.TypeDef, expr string) string {
+	if in.IsOptional {
+		return expr + ".map { Indirect($0) }"
+	}
+	return fmt.Sprintf("Indirect(%s)", expr)
+}
+
+// swiftModel returns the object or input of the given name.
+func (p *Project) swiftModel(name string) (def.TypeDef, bool) {
+	for _, t := range append(append([]def.TypeDef{}, p.Definition.Objects...), p.Definition.Inputs...) {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return def.TypeDef{}, false
+}
+
+func (p *Project) swiftHasScalar(in def.TypeDef) bool {
+	if in.IsList && in.OfType != nil {
+		return p.swiftHasScalar(*in.OfType)
+	}
+	_, ok := p.swiftScalarDef(in.Name)
+	return ok && in.IsScalar
+}
+
+// swiftDecodeField returns the statement that decodes a model property from
+// a keyed container named container.
+func (p *Project) swiftDecodeField(owner def.TypeDef, field def.FieldDef) string {
+	decode := fmt.Sprintf("container.decode(%s.self, forKey: .%s)", p.swiftWireType(field.Type), field.Name)
+	if field.Type.IsOptional {
+		decode = fmt.Sprintf("container.decodeIfPresent(%s.self, forKey: .%s)", p.swiftWireType(field.Type), field.Name)
+	}
+	if p.swiftIsBoxed(owner, field) {
+		return fmt.Sprintf("self._%s = try %s", field.Name, p.swiftBox(field.Type, decode))
+	}
+	return fmt.Sprintf("self.%s = try %s", field.Name, p.swiftUnwrap(field.Type, decode))
+}
+
+// swiftEncodeField returns the statement that encodes a model property into a
+// keyed container named container.
+func (p *Project) swiftEncodeField(field def.FieldDef) string {
+	value := p.swiftWrap(field.Type, "self."+field.Name)
+	if field.Type.IsOptional {
+		return fmt.Sprintf("try container.encodeIfPresent(%s, forKey: .%s)", value, field.Name)
+	}
+	return fmt.Sprintf("try container.encode(%s, forKey: .%s)", value, field.Name)
+}
+
+// swiftUnwrap converts an expression of the wire type to the model type.
+func (p *Project) swiftUnwrap(in def.TypeDef, expr string) string {
+	if !p.swiftHasScalar(in) {
+		return expr
+	}
+	if in.IsOptional {
+		inner := in
+		inner.IsOptional = false
+		return fmt.Sprintf("%s.map { %s }", expr, p.swiftUnwrap(inner, "$0"))
+	}
+	if in.IsList && in.OfType != nil {
+		return fmt.Sprintf("%s.map { %s }", expr, p.swiftUnwrap(*in.OfType, "$0"))
+	}
+	return expr + ".value"
+}
+
+// swiftWrap converts an expression of the model type to the wire type.
+func (p *Project) swiftWrap(in def.TypeDef, expr string) string {
+	if !p.swiftHasScalar(in) {
+		return expr
+	}
+	if in.IsOptional {
+		inner := in
+		inner.IsOptional = false
+		return fmt.Sprintf("%s.map { %s }", expr, p.swiftWrap(inner, "$0"))
+	}
+	if in.IsList && in.OfType != nil {
+		return fmt.Sprintf("%s.map { %s }", expr, p.swiftWrap(*in.OfType, "$0"))
+	}
+	return fmt.Sprintf("Remote.%s(%s)", in.Name, expr)
+}
+
+// joinArgsForSwift returns the parameters of a Swift function for a list of
+// arguments, optional arguments default to nil: `name: String, email: String? = nil`
+func (p *Project) joinArgsForSwift(in def.ArgDefs) string {
+	var out []string
+	for _, arg := range in {
+		param := fmt.Sprintf("%s: %s", arg.Name, p.swiftType(arg.Type))
+		if arg.Type.IsOptional {
+			param += " = nil"
+		}
+		out = append(out, param)
+	}
+	return strings.Join(out, ", ")
+}
+
+// swiftVariables returns the struct holding the GraphQL variables of an
+// operation, one field for each argument.
+func (p *Project) swiftVariables(fn def.FuncDef) def.TypeDef {
+	out := def.TypeDef{Name: strings.Title(fn.Name) + "Variables"}
+	for _, arg := range fn.Arguments {
+		out.Fields = append(out.Fields, def.FieldDef{Name: arg.Name, Type: arg.Type})
+	}
+	return out
+}
+
+// swiftResponseData returns the data struct of an operation's response. The
+// result is optional since errors null it.
+func (p *Project) swiftResponseData(name string, result def.TypeDef) def.TypeDef {
+	result.IsOptional = true
+	return def.TypeDef{Name: "Data", Fields: []def.FieldDef{{Name: name, Type: result}}}
+}
+
+func (p *Project) swiftScalarDef(name string) (def.ScalarDef, bool) {
+	for _, s := range p.ScalarDefs() {
+		if s.Name == name {
+			return s, true
+		}
+	}
+	return def.ScalarDef{}, false
+}
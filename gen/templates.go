@@ -1,1396 +0,0 @@
-// This file is automatically generated using github.com/mjibson/esc.
-
-package gen
-
-import (
-	"bytes"
-	"compress/gzip"
-	"encoding/base64"
-	"io/ioutil"
-	"net/http"
-	"os"
-	"path"
-	"sync"
-	"time"
-)
-
-type _escLocalFS struct{}
-
-var _escLocal _escLocalFS
-
-type _escStaticFS struct{}
-
-var _escStatic _escStaticFS
-
-type _escDirectory struct {
-	fs   http.FileSystem
-	name string
-}
-
-type _escFile struct {
-	compressed string
-	size       int64
-	modtime    int64
-	local      string
-	isDir      bool
-
-	once sync.Once
-	data []byte
-	name string
-}
-
-func (_escLocalFS) Open(name string) (http.File, error) {
-	f, present := _escData[path.Clean(name)]
-	if !present {
-		return nil, os.ErrNotExist
-	}
-	return os.Open(f.local)
-}
-
-func (_escStaticFS) prepare(name string) (*_escFile, error) {
-	f, present := _escData[path.Clean(name)]
-	if !present {
-		return nil, os.ErrNotExist
-	}
-	var err error
-	f.once.Do(func() {
-		f.name = path.Base(name)
-		if f.size == 0 {
-			return
-		}
-		var gr *gzip.Reader
-		b64 := base64.NewDecoder(base64.StdEncoding, bytes.NewBufferString(f.compressed))
-		gr, err = gzip.NewReader(b64)
-		if err != nil {
-			return
-		}
-		f.data, err = ioutil.ReadAll(gr)
-	})
-	if err != nil {
-		return nil, err
-	}
-	return f, nil
-}
-
-func (fs _escStaticFS) Open(name string) (http.File, error) {
-	f, err := fs.prepare(name)
-	if err != nil {
-		return nil, err
-	}
-	return f.File()
-}
-
-func (dir _escDirectory) Open(name string) (http.File, error) {
-	return dir.fs.Open(dir.name + name)
-}
-
-func (f *_escFile) File() (http.File, error) {
-	type httpFile struct {
-		*bytes.Reader
-		*_escFile
-	}
-	return &httpFile{
-		Reader:   bytes.NewReader(f.data),
-		_escFile: f,
-	}, nil
-}
-
-func (f *_escFile) Close() error {
-	return nil
-}
-
-func (f *_escFile) Readdir(count int) ([]os.FileInfo, error) {
-	return nil, nil
-}
-
-func (f *_escFile) Stat() (os.FileInfo, error) {
-	return f, nil
-}
-
-func (f *_escFile) Name() string {
-	return f.name
-}
-
-func (f *_escFile) Size() int64 {
-	return f.size
-}
-
-func (f *_escFile) Mode() os.FileMode {
-	return 0
-}
-
-func (f *_escFile) ModTime() time.Time {
-	return time.Unix(f.modtime, 0)
-}
-
-func (f *_escFile) IsDir() bool {
-	return f.isDir
-}
-
-func (f *_escFile) Sys() interface{} {
-	return f
-}
-
-// FS returns a http.Filesystem for the embedded assets. If useLocal is true,
-// the filesystem's contents are instead used.
-func FS(useLocal bool) http.FileSystem {
-	if useLocal {
-		return _escLocal
-	}
-	return _escStatic
-}
-
-// Dir returns a http.Filesystem for the embedded assets on a given prefix dir.
-// If useLocal is true, the filesystem's contents are instead used.
-func Dir(useLocal bool, name string) http.FileSystem {
-	if useLocal {
-		return _escDirectory{fs: _escLocal, name: name}
-	}
-	return _escDirectory{fs: _escStatic, name: name}
-}
-
-// FSByte returns the named file from the embedded assets. If useLocal is
-// true, the filesystem's contents are instead used.
-func FSByte(useLocal bool, name string) ([]byte, error) {
-	if useLocal {
-		f, err := _escLocal.Open(name)
-		if err != nil {
-			return nil, err
-		}
-		b, err := ioutil.ReadAll(f)
-		_ = f.Close()
-		return b, err
-	}
-	f, err := _escStatic.prepare(name)
-	if err != nil {
-		return nil, err
-	}
-	return f.data, nil
-}
-
-// FSMustByte is the same as FSByte, but panics if name is not present.
-func FSMustByte(useLocal bool, name string) []byte {
-	b, err := FSByte(useLocal, name)
-	if err != nil {
-		panic(err)
-	}
-	return b
-}
-
-// FSString is the string version of FSByte.
-func FSString(useLocal bool, name string) (string, error) {
-	b, err := FSByte(useLocal, name)
-	return string(b), err
-}
-
-// FSMustString is the string version of FSMustByte.
-func FSMustString(useLocal bool, name string) string {
-	return string(FSMustByte(useLocal, name))
-}
-
-var _escData = map[string]*_escFile{
-
-	"/templates/.DS_Store": {
-		local:   "templates/.DS_Store",
-		size:    6148,
-		modtime: 1507518939,
-		compressed: `
-H4sIAAAAAAAA/+zYMQoCMRCF4TcxRcAmpWUaD+ANwrKewAtYeAX7HF2WeWBg1U6My/tA/gU3KhYxIwCb
-7rcTkAEkeHHES4mPldBdG19DRERExmaetP/1BxGR4Sz7Q2Er27zG5wMbuzWZLWxlm9d4X2Ajm9jMFray
-zctNyzh8GN/ZOKEYpxArbP3OdyPy73aevPz+n9/P/yKyYRbnyzw9B4L1DQCu3fWnQ0DwPwsP3VodBEQG
-8wgAAP//agCIbQQYAAA=
-`,
-	},
-
-	"/templates/Makefile": {
-		local:   "templates/Makefile",
-		size:    52,
-		modtime: 1510460999,
-		compressed: `
-H4sIAAAAAAAA/8pNzMyz4uJMz1fIzCsuSczJ4eIqKkUT4ayu1vNLzE2trVUoTi0qSwUEAAD///hjl+00
-AAAA
-`,
-	},
-
-	"/templates/api/api.go": {
-		local:   "templates/api/api.go",
-		size:    1831,
-		modtime: 1511806433,
-		compressed: `
-H4sIAAAAAAAA/4xUTW/bRhA9c3/FlkAcUlAptC4KVIAPiWwhOjQQLPfU9rCihtQi5Cw7OxQSCPrvxX5Q
-ohUliA8mVzPvzZuZx+1U+UnVIFWnhdBtZ4hlJpK0ajkVSarNTJuedeMOxrr/rFtIhUjSWvO+3xalaWeo
-eK9wa4gMzY7H4qNq4XSaqU7PLNABKP2hfMuKIRVJTarb/9fIVxCARmEJsxj8uTapyIXgLx3I96r8BLiz
-0jL1JcujSDaOS3rGwr+TOMVsMoafwZrmADRCTAYWl1j1WMqFwUrXPUFmyz20aqkbQNU6WtJYT+V2qDtA
-c0cUkuX8QW782xX6AstFQlBry0AfFO4aIBtzxzmDmptk35MyiaMqAtJJc607YeMRHO8G7Mnp4Z5QDsg/
-e8trRRZicQK1c6UXBhmQ7ZWafCrvHPVF9Df6u9b2rVHumbsiYLN0WH06lXfBVjFEx8Ayl8P0/MbnZ9Lg
-gNNY1lUf1et55vHpNLjQVAKRG1z4GorniD/jcpHoyif99CBRNw6YVC0Xa9LIVZYulW5gJ9lI0wFGodLB
-5ds39u1cvjn8g+lUVmeXAFEuksTY4umz5uyXXCSXBQV5vrzvajaT76juW9dMtHlpEKFkbfAd1eNPY6nJ
-spQTjXz/q0jeQ2UI5CRQRrInLM0OyMZ5gT+uHjON5wkNG1w9eme9No5Pvcw74F90C47BXSGFO4zHHAk0
-Fn+9LLK8WBpqFWc+93m5uL+//+Oab9GTNTTW9BXdVln4/bdiwzvfkca6CK29mE0Y4d//br+wk5Vf069V
-DSusTCjgLpLhl1xOuvh6vkguNe+uY84LlhXx6nEu3Z9G50h3nookAdwNAR96cmcX2Cv7ET7zGfMhnGNo
-TXDQprfzGBrOU+eTsMVHeLXFHVy2eFnUjalFd403GLDfm7hlOn8mN8YetGwuzDc+mFg+TW9Y3TINTl8Y
-PABZbXDozFuZzQp5zV5eMHfun45aV1KjfPiqFOrG1zK9vxc1cjbx4oZdmp7FSfwfAAD//+zrrVcnBwAA
-`,
-	},
-
-	"/templates/api/pageInfo.go": {
-		local:   "templates/api/pageInfo.go",
-		size:    474,
-		modtime: 1511806335,
-		compressed: `
-H4sIAAAAAAAA/5SQzUrFMBCF152nmJU0d3EfQHClgncjF32CmE5jsSRhMimK9N0lP0VwYWmWH4fzZU7Q
-5kNbQh0mAPkKhEFburjRv1D080KMUTgZwW/oomiWywPmF4UnZ6EjNzTyy951fKZPKezN+7mQK9My+RQr
-WQHG5Az2jKe/RoWvWXSfOHruFZ5qb/6BKQxv75Cc8QO1DJ/b1xR0TJLY4U2N7oge3XBIU649Knmqc1y1
-pV6V87OjVfC5rbVfsi34T9EWgRV+AgAA//8sr7Hu2gEAAA==
-`,
-	},
-
-	"/templates/api/server/server.go": {
-		local:   "templates/api/server/server.go",
-		size:    1619,
-		modtime: 1511806201,
-		compressed: `
-H4sIAAAAAAAA/6RUwW7jNhA9i18x0aErBQqVQxcovPAhu9k2OWTXrd32UBQNLY0tNhKpDEexg8D/XlCk
-DSc12gLliUPOezN8nJleVQ9qjeCQnpCE0F1viSETSYqmsrU26/JPZ00qklTbUtuBdesNg1w2zL3fOyZt
-1i4VIknXmpthKSvblUZxo8zSElkqX17kF9Xhblf2D+tSDdyk/8nbsWL0zGtSffPYwisMYqtMhWW8vFjb
-VORC8HOPcKNM3SKBYxoqhheRzD0XwEgpR4NEMq8a7BScRwoZbLETYjWYCrIGziNTDnOv0s1iMcs24B8v
-f0LXW+PwV9KMVADBeTx/HNBx7qM+KQJFa3eUSPLjgPQM+xX0g7fr3gs/SR+9b3ovkuRrj6RYW+O1+ReU
-PfYd0b8o0mrZohvdOtX/Fhh+14aRVqrCl90e/bT39cidEElZgn+TJoTZ1/lCJHoFJO+QG1vD2TSoEcyZ
-deGR49ln/5/ZpoA0ahKcvli+alu7wXoCd4NjWAZioOCVFvDt5fv8KLiq93ewtPWzSGrFqgAkgskUQmFK
-73bVthnJj7Z+zsc0vcfZFIxuT2SFRNHIC3h/eZmLJCHkgcwh9GfjBkL4ZA2j4YuFr60GVY0E2oHq+1ZX
-o9Jjo4ikGqtvMgWSN6Ob/AE5S4/xacjsLDaOvFFuRrjS22wEF5C+pU3zf5D0o6onr/ProqTv3vK8G4U9
-+cwtk6rYl+rQoWF3EG8yBQ+VP5tOkWtUmwXpv/FVnX/43wLPFDmEOTqnrYEV2Q7iw0Ti4ulkCn5myO/J
-dvEyozzgr5GROm0QVFXZwTDcXosk7m+vC/jDw5vQ8aFEBm4W9gFNFvnlaOWHePL2GqZwoAhxrur6kCVb
-qLzgW/YfvvUB9tBP4Tyjwy7P9xJjNTCKhND1Madx2kh/k1W8LcZJIcfxEPevmj6eHVrZE9uBi1f/dBd/
-yUc52QLH+m9ikWa5nP+tTE8U4geoGv9fPB14dfGdr+SNHMdfZgfOxU78FQAA//9DPO2kUwYAAA==
-`,
-	},
-
-	"/templates/clients/ios/.gitignore": {
-		local:   "templates/clients/ios/.gitignore",
-		size:    21,
-		modtime: 1509838287,
-		compressed: `
-H4sIAAAAAAAA/9JzCY4PLskvSuXS0qtIzk9JLSjKzwIEAAD//0oeZPsVAAAA
-`,
-	},
-
-	"/templates/clients/ios/Makefile": {
-		local:   "templates/clients/ios/Makefile",
-		size:    14,
-		modtime: 1509838289,
-		compressed: `
-H4sIAAAAAAAA/0rMybHi4qxIzk9JTU/NAwQAAP//yNknqw4AAAA=
-`,
-	},
-
-	"/templates/clients/ios/Sources/Project/AppDelegate.swift": {
-		local:   "templates/clients/ios/Sources/Project/AppDelegate.swift",
-		size:    2573,
-		modtime: 1511809191,
-		compressed: `
-H4sIAAAAAAAA/+xUTY/bNhC961fMoQcZcLV3Aa7relvU2O0GyHaTQxAEtDiSBksNVZKy4Rr67wUpWR92
-0uRQoD1UF5ucecM3X+98hu9YVAjpCpLdm+etImSXPPmrto2oqrVx8LJ7IHc5nM8B0bb+LvrxZbepa0WZ
-cKT5N0EcZUpYC5u6vkeFhXCYwsvuLdpas0SzhBni4gPnKAIAOAgDR2Kpjx71Pvxbd6a84QzECI0/TU/p
-PO4SJMlfiMmWj6LhrCQu3pMr39TeakGFy/6UwocZ+HFqfMATpLDh08c1rIBJLeD7H+AnrRWcAy//dZRh
-NXCOcyOqkPlzZhA5qQRxstcNS7u4gq2TvcheC+ONW620gRUkx5Ic3jgard07wuNWszNaKfS+m7oez/Ft
-9Eq84gOeNizfkaW9womPQdcYBmea7rE2aqMoJxYKhj6OwX0+T+JARSjThETfvUzzAZmQMwRicvFiUiOL
-Kk/C7W0WKfyqK5xmsYgG4N0dPJJ1yJBrA8/Oj0tWCi7QDj5P2lHet2+L7NAkttnbzNAeY6fTcWzvSW4D
-eApZwBk+HFG8BpYfgb0NiIf4lwTWSSlYKgwsujjxEDqx/nYsbtuXNPzoAxpDErs5PhAe70lu6hqF8ZPM
-VAmHMg2TNStbU6NJ5v4X7/Gpb2TVTlbpFvIJgmva1Xhx6Wrfg52vKlo3Fl2hA9G48tmJAmHVoRN/ow39
-GQrrHy9wFuct/tGQQRBZphvuIgz+g2PRCCOn0VeQZJoZM4cSUFmc1Mh/lENt0Prey6sVIQubxpWzeYWr
-r1+EuzvYKINCnsCW+khcBA5+snvsDNnOToduveavr5OeVjznEC+Wk67nQllcQqarWmGnZ15qoluKk+ma
-VvWebEXWdmyPJTIM1Yr+gRJ9AbdOZPduPKbiteRvMmlHnRkU5loMXnZX9Doyft7QGG28FVbw8+V/3KuF
-RK8vE+qfkYWGR2HwG72E3OjqqwLx1VV+1ELGX1zczvovyFXYySHIN2pVyOsKeSMO8xb+Lm4m3/fxM9f/
-N/M/2sy/AgAA//9AB44GDQoAAA==
-`,
-	},
-
-	"/templates/clients/ios/Sources/Project/Assets.xcassets/AppIcon.appiconset/Contents.json": {
-		local:   "templates/clients/ios/Sources/Project/Assets.xcassets/AppIcon.appiconset/Contents.json",
-		size:    2017,
-		modtime: 1507519277,
-		compressed: `
-H4sIAAAAAAAA/7yVwU6EMBCG7zxFM2c0pYtdt69iPDRLXRqlNRTNRMK7m4JBjaERM5ZDU/KT+fKVmXQs
-GGMMbKcvJoBid/N7fMZ1t3zRWN+BYmCfW+8MlN/jYN9MTAVHwX+EZ/20pAhrMpX/ijqQok4oTpmskihS
-q5pjnelfpVGkVpKjzGSVRu220s3fWr3KBdp9eAlQss0pjWhHdxuUbnFCI+Kx3QYdJR5lDqM0iNDo9nB9
-g3Ehg/lw1en+0QzWXbaoFRc1xuV3Zznv7suPi9k9eFBf+PBq+mC9A8Wqz3KgX4bW97EWnn1jlnJTMRXv
-AQAA//9M7Bn04QcAAA==
-`,
-	},
-
-	"/templates/clients/ios/Sources/Project/Assets.xcassets/Contents.json": {
-		local:   "templates/clients/ios/Sources/Project/Assets.xcassets/Contents.json",
-		size:    72,
-		modtime: 1507519254,
-		compressed: `
-H4sIAAAAAAAA/6rmUlBQUFDKzEvLV7JSgPDAImWpRcWZ+XlKVgqGOgjRxNKSjPwiJSsFpYrk/JRUJbBM
-LVctFyAAAP//zgIcrUgAAAA=
-`,
-	},
-
-	"/templates/clients/ios/Sources/Project/AuthController.swift": {
-		local:   "templates/clients/ios/Sources/Project/AuthController.swift",
-		size:    206,
-		modtime: 1511809032,
-		compressed: `
-H4sIAAAAAAAA/0zMMcuDMBDG8T2f4oZ30CUfQHiH4iQFOxS7h/S0B/FOktNSQr570cU+4++Bf87wx25G
-aP7Bdrd7GwhZbb9TKYbmRaLC0F1JjRmJXQAfXEpwWfXVCmuUEDA2MHS922hySsKnQzYGAMALb8iE7BGI
-Sasa8nHsSxhGe2gU0Qfh+zfcCjN6PalK+gnYgJ2irAs+6/ooFVPMNwAA//+qSbYjzgAAAA==
-`,
-	},
-
-	"/templates/clients/ios/Sources/Project/Base.lproj/LaunchScreen.storyboard": {
-		local:   "templates/clients/ios/Sources/Project/Base.lproj/LaunchScreen.storyboard",
-		size:    1680,
-		modtime: 1507519247,
-		compressed: `
-H4sIAAAAAAAA/3xU33PiNhB+v79iq3cbDA2kMzg3QMmVNsx1Ak0vfVvkBesia11Jzh3319/IhmDn1wvW
-rJbvW+1+304+fi80PJJ1ik0qkrgvgIzkTJl9Kv7ZXEeXApxHk6FmQ6kwLD5efZhkLKuCjAd/KCkVkosY
-y1JTvDSe7A4lzSqlM7LDeM6SccOVzOO1Z3vYMtos/rKciTPvMPB6Zu3unkoZJoNBnIwEuIPzVJwvxtPB
-eCzAo92Tv62MVwWlQn1et6gElJZLsv4wlZKcm7PxlnWo35CAytG08qzxwJVPxf1iLUBjZWS+lpbIHEOV
-o41F5eesNUmv2LjzzRp3NLWEp5BkzXaFXuaUHUPKKK9Q3yn6dixAk01FP/kz0mXEdytx9QEAYJJRSSYj
-IxW5JlSHS13tlwZURsarnQr/fbvT8XJ2fv/futor0+pwMkz6v8bJQPRa+BJL3Cqt/AEMhiaGNwFaQmha
-A/tKZeQEFMpsOuP5Le6/D3VSiAOHj5SBMuBzgi+SM4JL2LEt0L8CfHkGnvRe9mXiJJlOk36JotBgOHc4
-ilrXdT7Uv8vfU7H4Yxct/42mg4U4J9WJvP1K0rtutL557MwPVNYZYYO9omJLNjB0s8VLvCdMeKBDkx/U
-YzwZv+KMUuEkatrwtdJa1HT/0UU02kYDP3wDr8a0JH2DubNYkIDvqegHZx2O328q83kqhuMLATmpfe5T
-MRqN24N8AYqVZ0tO/VBmv0L30BA8jx6x1+oHbjUd5d9QdGLvMdUGauC3KB/2liuTzUNQgA2eSgTsG3cm
-Ara6ovqAusyxPtUA6xJl2EiV81wIaL7z1o27/TR7t44wkJta/p+C+puK3NHtzTxGm4fo8yKaze7fQpr0
-AswrYup19fFKRqlRUs7B09A6L1tbYDm7Vtb5W3Ilm4xsU5W6/xr99X+0wKReUPYGt6RTUadCK/eZYHdd
-qGcPmvReNcakZGWOapNoHtHdsMSwImvZXQxr1QWltbdEr6Y+uftk5UnvtCyufgYAAP//1Va/hJAGAAA=
-`,
-	},
-
-	"/templates/clients/ios/Sources/Project/Cells/FormInputCell.swift": {
-		local:   "templates/clients/ios/Sources/Project/Cells/FormInputCell.swift",
-		size:    1999,
-		modtime: 1511113727,
-		compressed: `
-H4sIAAAAAAAA/7xVzW7bPBC8+ykWOUn4FMI+fD0IcAvEqBOj7SU/BXoqKGllE6VJgVzZsYu8e0FKoWRF
-idse6oMhLpezs7NDSWwrbQgeVp8ETSa55NbCUpvtSlU1LVDKFB5W9zyT+FXg3gXg5wQAwP9Z4iRykEhg
-sLa4KlCRKAUamMPFCc5Fd2rHDUieoVwpiwRzeFh9LNboVzYiXaXwfwISS0ph9i6BTBPpbQrTBIxYb3w0
-DkilQFm8jjQdQ5qNQ+24rDGFOzJCrT+0jbqfQaqNako5HRjhI/ndp66tyogdJ4wsUgySHw9do+6ME/Kz
-W8C8h+yk2wnce+5+O4rDpttgGc9/rI2uVbHQUhuf6J8ig4Xvb20QVQqzBDLPf5oAl9WGpzBlsw7NoixZ
-rhWhIt8EL4q7OnNFIvcXD9t1wabJltMbbQZpvF/wkZYu8HqrIWXYbi6Rm6uaSKsvukCYA+Nyzw/2j1SZ
-BVWm/0YVvUNjRIFQ1ioHK454v+G0FGSj736ZwuL6Thwxhsv37eNAG++UpXA2dgeYcC6+OkTdVYmZUKVQ
-gvAGnXt72gUADzzvbMdOuDzXiJmu6QV8QDuB9aMd4dVdvDO8fGLLq7tCJ7yea/R49eBf8mpn0egY7UVB
-mxS2/DEKGjAfTLriTSBOYIPNze9Smwj810tuQvHwjp+OWfKDrql1i43i3kBtXaFhw4RxgXuDz5yjLXtt
-/n897u5gSCwN37qji+tbzCnSRqyFSnsvZtaEkta+odx5m4x1MTbO8OL4TXOEg4OhdiWb5WXvo8BIE5c3
-2oijVsTlKcZbSvQwmhDTZWmRvkUDEeNniQKtM76pDFbc4FKbW/fVHDHOi4wR2u4bBHNQQrbVniaTXwEA
-AP//c91UJ88HAAA=
-`,
-	},
-
-	"/templates/clients/ios/Sources/Project/Cells/FormTextAreaCell.swift": {
-		local:   "templates/clients/ios/Sources/Project/Cells/FormTextAreaCell.swift",
-		size:    1848,
-		modtime: 1511113783,
-		compressed: `
-H4sIAAAAAAAA/6xVzW7bPBC8+yn2y0nC5wrJITkIUIPWiVujDVDUca4Bba7kRWnSIFf+K/LuBSnrz3HS
-S3UQxOVyODs7pGi1NpZhNvlGPBgslHAOxsauHnHHnyyKESqVwmzyKOYKnwi3PjD0AdyxH9+hwkIwwu8B
-AEB4ORZMC1DIYLF0OJGomXJCCxlcnMJfDMKijbCgxBzVRDtkyGA2uZcFhpGL2KxTuB6CwpxTuLoZwtww
-m1UKl0OwVCx99DpukHJCJd9GujyHdNWBummhNkKVmMKULeni9linfyxyaXW1lZciYdxxmH1pFkuSs7UU
-jClEUQwfPsKTIRnftmKtLW0EY+SQY1DisG+V8KBe/O9+AFlnay/thnAbigvTUdxMOlR5sjCaUYcWJULK
-aTn3+ZF/xacV+GDF+4jyDrGm2rRjgre51Rkden4ukbVtskC3P0luurBGqXvtXSchg1woh/0kL/bIaBak
-0SaKNI6tKFao+YeQknQBGVy+s6Q2R3JAa/p5v3A/N8LKO3Ircu7BSM8zIc1oxYJpg/9I6/BhNmgtSYS8
-1AtwdMDHpeAxsYuewzCF0ZcpHTDYp/o8kTu4ZUy+HL8gIV/b533Unqc4IZ2TJsav6C3e6UcDEICz1npJ
-j0u9R5yYkl/BD3pwwSRn+LSn8i98QuKRT3u+enzqPTp8OvCDU90r3aItSV6msBK7qKk5CcFhu2kViIew
-xOo6aFOrCPzfSa5C8fHgn2mpEntT8tEVLoo7zXPl2pv3JKELFRC4vmxJjpZCFxg9N7HuOYxPfOFYWH4l
-49yUWrqgZi9b4/Z9yfsaNuC1frVaSWFRMDoehwY/iEITlxLjtseUd5YfNf0vqxnUkbYY/zRX6W3HLC+d
-+/aM9GuLa2FxbOxP/yM6o/2rjGa+f69DBprUcbeXwZ8AAAD//7zVvl04BwAA
-`,
-	},
-
-	"/templates/clients/ios/Sources/Project/Cells/LabelCell.swift": {
-		local:   "templates/clients/ios/Sources/Project/Cells/LabelCell.swift",
-		size:    628,
-		modtime: 1511113782,
-		compressed: `
-H4sIAAAAAAAA/2yQT2/iMBDF7/kUc7Ql1mIve4iUPSzaP9Ei9QD0WjlkApYcO7LHQVDx3StPo0Bpc3Ds
-Gfu93xvTDz4Q7Or/hopib3WMsNYN2hVaW8Ku3urG4rPBUy7AawEAwMuoAxgXkaCCXf27PWCdT1GQH0pY
-LsBiRyV8/7GAxhP5novBHI5clTelIZhRE4qIJMHqy5m1babIxpmCkaCa/PNnkWA0eGJ3bgs5N3NDudQ3
-GJ66tXEYoYLl3I5oO7X3jtBRdlC6bTepya9EXm5CASkFx3pcuwpZ8MaPGIJpEbrk9hDNBbdHTX8MRfHC
-xxJWfzfmghK+/Zy2D/QTAHcqfqN4nr/Ogv9SGdcZZwj/YZ6a+IQ1j0h9ALgTlsonutd8T/FVBqvPPtE0
-hijkHW1MAwb1eOEWZsbogu5zmMYn18aHOJP1tXgLAAD//4LOqvJ0AgAA
-`,
-	},
-
-	"/templates/clients/ios/Sources/Project/Cells/TextCell.swift": {
-		local:   "templates/clients/ios/Sources/Project/Cells/TextCell.swift",
-		size:    1039,
-		modtime: 1511113742,
-		compressed: `
-H4sIAAAAAAAA/3xTTW/bMAy9+1fwKAOZ0V12MJAVaJdsxophyEd3LBSLdggoUiBR2ZIh/32QYsf5KJaD
-I5GP5HskRZutdQzL6jtxltVaeg8L/MOvhL+fUesSltVCrjT2hlE0dIAvqLGVjPA3AwBIn510QMYjwxiW
-1US1WMWbF2y3JTyMQGPDJXz8NIKVZbabZHTUrpM1PyexZun0Qm5LEGI5e8nhw2d4taTyx6HY1tFOMgqP
-nIOWh32K5I5eeUEVxh3J+NPIsDsZB4TIz/7oK1QvbgwedXPtjCWerWFJBl2hyeDUyXaDhn9Kpci0MIaH
-/4RUXYeKAzp7jSM/UcSx5TCGRmqPt/557azWExMx6g4UyRa1NYwm6SqkUvOwirEifgaZDjk4k7Im21Hk
-WTrYHTpHCqEJpgZPB1ysJU+JvXhL1xKev87pgGkop+NNe6PaKUWJEV+kjXjai/SfF2QaMsT4DePcxR2l
-foLFVe0uZ17YwJfpTuTfo67l3gbu1HuRX5D0YRsndwM4u88MGic3cRIrG4zyN0K6yud9TEX7UPH27iaO
-wK9t0KoyjE7W/It4DcHFlzZ7GQEZqNcyOtDNpGmxhB/zdIi+UwhZ02esGDfVYE4DebJWXyjtH9KjCE7f
-tXpYnmN2zP4FAAD//8EXi0kPBAAA
-`,
-	},
-
-	"/templates/clients/ios/Sources/Project/ConnectController.swift": {
-		local:   "templates/clients/ios/Sources/Project/ConnectController.swift",
-		size:    3318,
-		modtime: 1511809197,
-		compressed: `
-H4sIAAAAAAAA/7xWS2/jNhC++1cM0h5kwNUWPQpw27wMCFs0gZ0U6GlBi2OLLU0Kw5Edr+H/XpB6O3aA
-ZtOeJHLe33wc8nCA743YICRTiNOHxa1WaDj+3W8djyO1KSwxPKefFTeLwyFYHI9+b7RSRmjItHAObq0x
-mPGtNUxWa6QEroviSSw1/qFw1+3DYTQCANDi6x4KUlvBGDnkMWwFAW6E0reodQIzS5vUFCX7JUzhEMyC
-KTJk1eZAKRq3Kl4ca7FE7aPHgpnUsmSUT/jCMIWrex/oqidYMCmzjiqjE0crhVqeOHrUIsPcaonk/f1p
-S4LgFK6lJHTunHNWrHECmdWWEohxU/D+YiyJGteCEabgUK8uqf2N+6UVJJ/2hVeNA4R1DhfLKNlmolAs
-tPoqWFnTWBtr8JIVIZdkPuO+VcYXHio71Jh5fwve69cOKw9BNewdo/FbbCiEcztL8v8gxGMd66M58Zbf
-/4IOyi0wKwl9XfeGaQ9TYCovNvWDqCBfKb+XCnaLREoirEqTwVbh7k7J36yQ0bjXclcWSPFA2sran4Cv
-70Q9na5GrejTJ3hYrZBgjmvlmELlYAv/GfCKghzppmS2BqbwnN6IepUybqIQJIGrea14NQHnK04gLrRQ
-ZgIsaI2chLZNQARQEviuAshSlAsjNTb2464QI7ZqHTLzkWJS65wHwWF6kl8F5TkgHQvGOyVvc2HWGH2p
-NhJY+M9rZE/Uw3I8gC81jISO3QAuUXJ+T2T9AQhGnmC5pZpbMXpZ5ycsA6OwNmoddF1sLpGYUFsh7wSL
-utt1paHACsVFudwoHlBlXQqSIbkwGmHa3TLxVugSAbXDnkFIrFXpSL/EzG5wpsjxHF1hjUTq0a5jdbt1
-PJNEM9RgOphvl1MZaH1MNu09HmfVyYhCvUlV9qQNmbR/A7x/tcu/sj7qDXcHuIep3N37U2i0usdA1JyU
-NdmyQNml73K7izrrCTj0BVaHaHyZ5i1Zoi/dfwLPafsUmYApN0ukh9Xc7lxqFtWUAof1wUwNj+GHn/23
-V009r376ttB+3M0sze3umkEZiS+PgnMfs/4NkXsm4a7rHc6d4izvLGOyu544Ew7hx6TNtmVxqyFxJUrN
-SaPRJ9cJUd5dpJ/KM0szaxnpDYCfU6//y2uQ26nwbXlIJRdhxr4F97/H9h2joUX9fWe56cdxNMIXRuM8
-nGde3M+pv/Vn3u1d81o49EYkN9JFbkst5wFtD2CzP3ARenRj7Rn+tQan+JzBJvmAYdbgN3A1HPhnplzN
-ppXQDlsE/wkAAP//qlph8PYMAAA=
-`,
-	},
-
-	"/templates/clients/ios/Sources/Project/Extensions/CoreGraphics.swift": {
-		local:   "templates/clients/ios/Sources/Project/Extensions/CoreGraphics.swift",
-		size:    1714,
-		modtime: 1511114360,
-		compressed: `
-H4sIAAAAAAAA/6xUy27bQAy8+yt4iw079l2HHho0iVEUKFKksE/BWqIkAhLX2KUcO0H+vViu/H4kKeKL
-jOVqZjhDiuq5dQKP458knQ4uBdmTZXgc/8gKHLNH8fDa6QAAjEYjeEBpHHuQEsE3NVSYCxjOwFFRCpC+
-MNTrC+NArJjq3jp6sSymSuDm7rayRuBVr4SfU8QI1I8wWns7Typ2rpwzK2Lr06R/0QmlFykDTL8FOUdp
-YG6JBWbGYwaWVYMSXvmNDtW+MFWDOyqso4I40P9WhCP6ttBdJgowgFUSEHutlLfdOG7u/tALngrCgA8V
-lQSzleoraIG8Z0vecBoPvq+6T/FfshdyD66/bWmOpIbz7jNlUiagD7iOKMODiAdQYsgwaZ/799ap9M77
-re08k5RA4tcgoTmxQJwTk6z2mtIjvNeL3c+2sZU7LBwaQS+3ivfLFEzSZPieVCmNgMkyf8l628hXet//
-oPf9S94fDNgDpnJ6wFyoaCKHHYJvZuJMKphB7sIqhrWICZZUIdSGWAwxcRFrKbKgi0v1ydFsBR7ZE87D
-EtXEk03Hm42qiac7PswH8PVTfODket9PWLku2jw/2tfYyZWH2iynMLeehCzvDpG+NO0+aSJJ+0Lrzvsf
-maX6sQofWUxlGFguzPZHlU7OK538t9IdjZOoemv1vwAAAP//ctlBUbIGAAA=
-`,
-	},
-
-	"/templates/clients/ios/Sources/Project/HomeController.swift": {
-		local:   "templates/clients/ios/Sources/Project/HomeController.swift",
-		size:    300,
-		modtime: 1511809036,
-		compressed: `
-H4sIAAAAAAAA/2yOvU7EMBCEez/F6ERx1+QBIl2BcgUnEBQnaJEVL7CSY1v2JiksvzuygSB+3FjzzezO
-5owrpydCf0R3frgMlslJd19RKYqn4KPg8XzL8iVybhOlVKZGq1PCjZ9o8E6it5Zij+sQnpjWb4SsFAD4
-hWJkQ3iZ3YiFaT2xufPa7A/ILVFfmgPF7oerNlNYLOGI3XbIrnnlv4YkWujEZnjT7pX2zx+gx6V+fyt/
-xZs8fG4v7wEAAP//IEeBTCwBAAA=
-`,
-	},
-
-	"/templates/clients/ios/Sources/Project/Info.plist": {
-		local:   "templates/clients/ios/Sources/Project/Info.plist",
-		size:    1198,
-		modtime: 1508111241,
-		compressed: `
-H4sIAAAAAAAA/4yUUW+bMBSFn5NfwdAetofi5GWbJkpFglshUYICVNtT5MBNYpXY3rVJyr+fSEnH0jDt
-LZLP+c65vjHu3cu+sg6Amktxa0+diW2BKGTJxfbWzrP7m2/2nTd2PwSLefYzoZaquDZWks+icG7ZN4T4
-SlVASJAFVhKFaWZNnQkhNLYte2eM+k7I8Xh0WKtyCrlvhZokKBWgaSKuzc3UmTilKW1v7L7S/6rjjd2S
-F8Ybj9xnaLz5/awWZQUBHKCSag/CLGHLpXBJezweudogF1vv46eAPtFokTzSOFtFfvyQ+w/0s0u68wse
-fYGiNmxdwXsQ/UHneebPIrqK/cdhRliCMHzDAd8zkuUiyOfZapbHQURXYUDjLLwP6XKYJjYy4IXhUjBs
-nl7v5BL8xZkM+WO2vzLLucc/B0lY8cy2kDXqHcFPkmjIlu4kmq5oelJcuqfDdQcGnP7RW5ZlnSxRuoRf
-NUfQYbKTAhZp5zopDNZAeuo8jFgtil1qJDZrybDs3cxJ1QV0sgIBxJXUPOxSywAOvIA5U2zNK2446D6N
-IbLud5/OcH/4eoElPW2XkdZKSTRQhsIAblgBC+QgDGv/B/+Tk4fXnIlEg4ybgQKvq4hTX6kMmdBthRSK
-Grlp3jbSPcM3bVXJo/ZxzQ0ybCLJynO/0ei8hZFL+q83zNqIXIOOpaAvsFeGigIbZfqr37BKt+az1yWn
-74I3/h0AAP//6I/Tc64EAAA=
-`,
-	},
-
-	"/templates/clients/ios/Sources/Project/RegisterController.swift": {
-		local:   "templates/clients/ios/Sources/Project/RegisterController.swift",
-		size:    3742,
-		modtime: 1511809200,
-		compressed: `
-H4sIAAAAAAAA/8RXTW/jNhC9+1cMFj3YQKpt0ZsAt0iTGDC26AZWUqCnBS2ObaI0KQxHdryG/3tBSqIk
-xw6QxE19kUi++eC8x6G838MPRqwR0jEk06/ZjVZoOPnTTx0OA7UuLDE8Tr8obgb7fbA4HPzcYKGM0JBr
-4RzMcKkcI91Yw2S1RkrhuigexFzjXwq37TzsBwMAAC2+76AgtRGMQ4c8go0g8O5vUOsUJpbWU1OU7Icw
-hn2wCpbIkFeTPdBwFCF+OdFijtoHTwQzqXnJKB/wiWEMn/wuP3XmMyZllsPK5sjPQqGWR37utchxZbVE
-8u7+tiXBOZ+sWOMV5FZbSiHBdcG7syEkalwKRhiDQ704m0nJNheFYqHVd8HKmodd4Y2SrSXpzpkRcknm
-C+4atMEn7oMdasy9w4x3usJYgxFTeQjQMHcYjl7iE9dC6Y8g9M4HujSjwSlcS0no3EdR+w/u5laQbCgK
-JaxzeL0ceuT932oohHNenx8hiPs61qU18ZLf/0IOymWYl4R+X3eGaQdjYCrPknohKchn4LdKwW6QSEmE
-RWly2Cjc3ir5hxVyOOpQ7soCKemtxrX4EurrmWium09VqFOBHAvGWyVvVsIscfitmkgh84/nkY/gYVhv
-wP8+f4apYSR07HoyFSWv7oisF0gw8gSsLNW1T9CvtX7CMFQca6PooN1lc2kmhNoKeStY1NWodxo2uBJG
-aszK+Vpxr5TLUpAMyYXrfRwv1WQjdImA2mEH7n8R0Spijrld40SR4xm6whqJ1OGkpTxOHU5kEJoXjNt7
-4HwOLeTSSTRtB8a9DnQ+lR7qMtnET6eEau0O/TgNpb+qNp9Wj6sYP41vo/NCj3IZfmvfU3icxo+vKzDl
-eo70dTGzWzc1WXWOwVXP1Et7BD/+6p+dStQn+pf3hfYNYWJpZrfXDMpIfLoXvPIx69cQuWMSboPO8dwq
-zletZUJ221nOhUP4KY3ZNkruA35uAVFmESFxIUrNaYPosn/E5Jur4BvbxNLEWkZ6gYHHqcf/9pyF2Dje
-l4dUMgt9/CU+Xl/81/ePSMsbjn0k7G3ntKHyMBjgE6NxnolTf2Aep/7SnXi/t81lve90YG5Ws5UttZwF
-pnzxm/mei8Dv79aeEHc0OK7t87qm7+2Zwe8Ju/QCHbAhpueqf0+daI21whdCO4zU/BsAAP//qJz9J54O
-AAA=
-`,
-	},
-
-	"/templates/clients/ios/Sources/Project/Styles.swift": {
-		local:   "templates/clients/ios/Sources/Project/Styles.swift",
-		size:    2435,
-		modtime: 1511108608,
-		compressed: `
-H4sIAAAAAAAA/7RVXWvbShB9168YuC82OLq2Pq8EuiUE0oakoSBCH0Ioa3ktL6xWYrVq4ob896KVtCut
-nbihrR9k7ehozpwz4zEpqpILuLu6JsKyMGsKSAUnLD8XgpN1I3ANzxYAQIZqDIIIivWR47yhiOtAhipB
-SqYDFK0xlUd5+Y44bEsmUvIDx3Dx8ZKWSPQE7ad+JCLbQY3pdhSVmWzJHQMAx6LhDFahAeiriRXANwB9
-dRqwMgCy2jGFowAvlr4qKZQw/AmTfCd+V4yzPCHmAHAgxjshZpThqJi2L197MXdXlyUTdnd8n6Seza5x
-QdYl3SjUBm9RQ0UsDwNqPEFvVjbUNKJVTPta4KJ9OCu33WS1BdrDoC3gsZelwp2w+TGyrKQlb9ku2ptf
-1G42Y9B109Kc6Mtx7MStd/pVIY5yjqpdKvZtS27TL5PIqHyKBdQylsBt+rkRaE3xFD2bawvas12gJ1I0
-xY0afkg6Z/XPwXyDsHe+0T5IK5QRlkMCMwMMZ9MOz+FfcMy5kImO2YPUaovh/jZVm27Tbb5rvI/hnO0f
-DkftXgXaj93NpapkYT7lOOdlwzYX3UhJoBwvA2n2SwKnQf3GQy/pxbIs/CQwq0nJ+qXdVywv24ZlWmqv
-bfZtot5c9Qtj+j9AAozQOZz9D4dGjfwZbK0hGRHY+lYhyVbOnOSBpP9+nvghE92b/j0MaGP2R+05LHFW
-y6/O0sVEu2SZazO1l9OfvtUNJRIkk5ULwoQqNYF/ZE03RGCO6IzjTQzLBeQcYybv1rTB8gbRaodiWM3N
-hN1fMOqyHk240gltz3OD0HVDx1W5bS/wAsd3ougNFlxUYv922XbgR64fOWHgjgiD6L/Q9Vdh6GvC0Ft5
-Sz9aeu4pRrnUXmeM3MCJQm/KOA4OjOPYq4z9cvxzrRlv5r9k2ov1MwAA//9yH5WLgwkAAA==
-`,
-	},
-
-	"/templates/clients/ios/Sources/Project/Views/ErrorView.swift": {
-		local:   "templates/clients/ios/Sources/Project/Views/ErrorView.swift",
-		size:    930,
-		modtime: 1511113710,
-		compressed: `
-H4sIAAAAAAAA/3RTwY7aMBC98xVzdKSttXvpIVJaqdvdFnWlHlh6rZx4EkYydmSPaQHx75WdEAK75RDj
-eZM3773YtO2dZ1gvfxAvFo1RIcCT987/IvxTwnqZVjguFgAAO+UBE1gOPZ/hmOvpp0mvkOEIG2W1wYw/
-bpTtUBRwym2nCwvZgAwVrJdPusNl2gXBri/h4f4ODLZcwsPHO6gds9sOVU/dJpeLgaf3tFOMIiAXYNRh
-n5mNqtGcxb+kDVQzmQYZdslSdYZFMYEJkDZua/Q/2xeyGKCC+wkOaFqptF7FOnWK9Li87JGjt5ljcDsS
-54fbofekEdpoGwh0wNeN4mfiIH7nbQmP31Z0wAI+fBr/3qjOxp4ppZZekDnCL3uR10KSbckS43dMMYk3
-uqZc5NX0M2shXeQ54fjF/uPAqL2LPAYRRDHTGmKPXt42XKxMOlqvtggV1C5aHW78zA9MnvjesboM7aLy
-OseUzydU44omzHO8FqCYPdWRUb/i35SrJXPVOkQ3lU7vmHjDkedK4xpl6ID6K4bGU8/k7Kx3xZ5sJ2Sj
-MnIHjTPpVsnhOio7BXBa/AsAAP//GY0nU6IDAAA=
-`,
-	},
-
-	"/templates/clients/ios/Sources/ProjectKit/Apply.swift": {
-		local:   "templates/clients/ios/Sources/ProjectKit/Apply.swift",
-		size:    917,
-		modtime: 1511809043,
-		compressed: `
-H4sIAAAAAAAA/5RSwWqkQBC9+xWPYQ8zFy9z2gEZll0Wckkg8wWNlqaIVg9tNSQR/z3Y2hNnxgjx0vVe
-PZ9Vz+46/BLTEA4Z0oen09+aSTR9HKi+T7g5W6f4b70URtlKktCbkrRsBSc1SuiSBABYWLc7dAEMT0t1
-mZo8t14UGf6M1XZ3I/D6Yh1/BPNBNse3YnLOOmQQrkOjT/r5PFfvrs+l9pVk5nRptGoqQoa04Da3IpQr
-FT+bYlp59ftcIMNmc02G/3BPU2O4XuBzR0ZpMPpnlG6zamzBJX/bvl8iHI1XoywVSi85zPlcv28dNVbp
-gOdwptN+x/lOlTeuQE2KUYwsFlS3hA6O1DtBv5DCKBzq4zHSi7lMwoCidADLaU3qEUZ5QKspujLf7/e/
-T+pYqkN0mUS7i9FErEe+7BVVX2aRudymzwAAAP//qG1gsJUDAAA=
-`,
-	},
-
-	"/templates/clients/ios/Sources/ProjectKit/Error.swift": {
-		local:   "templates/clients/ios/Sources/ProjectKit/Error.swift",
-		size:    549,
-		modtime: 1511809046,
-		compressed: `
-H4sIAAAAAAAA/2yQQWsDIRCF7/6KOeSQQJCchZBD2kKgtNAcSw9mdxoEHRedLaXify+rm8126Unxfb55
-81KCFWmHoPYgT6/nozVILF+Gp5yFcZ0PDE++p1az8SRE11+saSBy6BuGlMr3nB9D8EFBObZw9K2+WIQk
-AAD+fnn2TbFSC2pGWmSwhlDBifg/sfG2d3SXs1gADmPUV1Rw5mDoupTtmCEqeL/l+TiIOfalQ8Gs+cH2
-AWMTTFdjV8tZ6oDcB7rNHANlIfCbkaLxtKgJUp1kyPA6oPOMCt7KWfTNzDui/ZSjM+yh0nI+aqKmpe7c
-9HSQTneQpvLXtd7VTg6X7VToaifrdTN2OizyGwAA//+R7dPqJQIAAA==
-`,
-	},
-
-	"/templates/clients/ios/Sources/ProjectKit/Extensions/Date.swift": {
-		local:   "templates/clients/ios/Sources/ProjectKit/Extensions/Date.swift",
-		size:    790,
-		modtime: 1508387059,
-		compressed: `
-H4sIAAAAAAAA/5SRQWvbQBCF7/oVgy+WIZFdDKVdMDm0uAnUtGAXSi5hqx25A6vZMDsOFUH/vawqKxLo
-Uh3Esvu+NzNv1us1nH5TBIpg4YyMYhUdVOTxBlwADgroSLOM6ucgCvtwYWeVAmcZ/lHkSIHhs1WE1ywD
-AHi+/PJUQlSrVIJHBanK7Xb70XSyfZDaqqLADl47IH1JVo1eJsp8NegGTVFaj+xsEn/qjzk5ZKWKUAwU
-FMOH95t3c6wPiYYdfO0OE26B/PTj+PT92/Hh52IOVqrxMXDCT/0xj1gGdnEvof5yOBnYzIFumAl2sGia
-prk9HG6dW56W9/emrk2My8fFAArqRfiN7x7afDVJ+cXKW7xHFeLzKNXeIoVZ9KoidqK8klAbiOirf622
-E1ti0ru8R3rbqHItcbcaFTlfrLhugVHTNtIffcSRZNQLkx+u2xmPFFK//6HldHdtWGX13+5pyiKNlKfd
-PbCivFhvYHMDkbhE01W95tBmfwMAAP//Lt6kJBYDAAA=
-`,
-	},
-
-	"/templates/clients/ios/Sources/ProjectKit/Extensions/Notification.swift": {
-		local:   "templates/clients/ios/Sources/ProjectKit/Extensions/Notification.swift",
-		size:    581,
-		modtime: 1510472599,
-		compressed: `
-H4sIAAAAAAAA/6SPT0szMRDG7/sp5tjCvv0Ae3ipCB63ouBV8mdSRrIzdTIpivS7i2FrW9SDmEMyDOH3
-/B6adqIGN1I5OiPhrsMXQy4kDKMYJQptf41sqPDWAQC0ax2pBKfR+Yx3WGq2tt5VnylAMWcUIFUOUKov
-QcnjwgTYTThcoFejm7CHWoi3A6yxBLcj3sLi/NMS/v2HB6HYhvF+458w2K2KSZA8e30cRavKUDCnVcTk
-araVi3HjC+oedZFEx6bALVUaZwCm3MNzxYrzPOu0Z9noh1P3b0pWPtV8BJnjBrji1x6SyvRT8+WZ/IW1
-4iR7/BQ/IvsZ9MX/j5a/9TjGHbr3AAAA//+KSRvFRQIAAA==
-`,
-	},
-
-	"/templates/clients/ios/Sources/ProjectKit/GraphQL/README.md": {
-		local:   "templates/clients/ios/Sources/ProjectKit/GraphQL/README.md",
-		size:    0,
-		modtime: 1510427329,
-		compressed: `
-H4sIAAAAAAAA/wEAAP//AAAAAAAAAAA=
-`,
-	},
-
-	"/templates/clients/ios/Sources/ProjectKit/Info.plist": {
-		local:   "templates/clients/ios/Sources/ProjectKit/Info.plist",
-		size:    777,
-		modtime: 1507524055,
-		compressed: `
-H4sIAAAAAAAA/3ySQW+bMBiGz+VXeKiH7hCcXKZpcqkIdio2Yiww2XZCDNzUqmNbxm2afz+lSTUtjJ2/
-7330vPaH7l53CrwIN0ijb8NFNA+B0J3ppd7ehjVfzT6Hd3GAPuAi5T8ZAVbJwQNWL/MsBeEMwsRaJSDE
-HAOWZxUHi2gOIaEhCB+9t18g3O/3UXvcijqzOy4OkDljhfOHXA5+tojmUe/7MA7Qif6XThygXnY+Dq7Q
-kzjE6Wr5rHslsHgRytid0L4UW2k0gsdxcIUG76Textc3mGxIXrA1obzJE3pfJ/fkI4Ln+QWPvIru2be/
-lBiDyA+S1jxZ5qShyXqakfVCe/kghRszWFngOuXNsqY4J02GCeXZKiPlNE0/GCw7L41u3WFzepNL8Kdo
-PpWn7e4fXd49/luEtd1TuxX8YEeE1fr7t6lY9WicP4tWbxuX6cW07kTB65u0LsvjF7Ky+EpS3mxIWWUF
-HcnTijmpO2lblap2GM4kAAB4h/2JIHg6KgTfTi4OfgcAAP//64QhfwkDAAA=
-`,
-	},
-
-	"/templates/clients/ios/Sources/ProjectKit/Methods.swift": {
-		local:   "templates/clients/ios/Sources/ProjectKit/Methods.swift",
-		size:    312,
-		modtime: 1511809049,
-		compressed: `
-H4sIAAAAAAAA/5yMvWrEMBCEez3FFC7sRqQ2pAoY0iSFn0BRNrBEXhlplQSE3j3Yvjvu4Kqbapifr1Z0
-4hbC+Az7+j6/BCZR+7ZFrRle1pgUUyzy6ZSjGEN/SpI5Cmrdr62hGgMAa/kI7JHVKXt8FfH4Yfql1A+o
-+2JToiUq2VOj8ZtkhHAYUJEol6BguayPx5baKHPxnnK+gp21Jhbtu6fhpmn3MZPjUBI9gDlcM+0/AAD/
-///pqVE4AQAA
-`,
-	},
-
-	"/templates/clients/ios/Sources/ProjectKit/Project.swift": {
-		local:   "templates/clients/ios/Sources/ProjectKit/Project.swift",
-		size:    1877,
-		modtime: 1511809053,
-		compressed: `
-H4sIAAAAAAAA/6xVXW/TMBR9z6+4VDwkUvH2OEVkPHQgIbEhVuAVuc5Na3Ds4I8hFOW/oziO63RVedke
-FufG59jn3I/2PbyWtEUoKyAfP283gqO05GEMDUPG205pCx+UkzW1XMks69xOcAYCLfS9xw7DHa83Byr3
-+KAsbzjzW6GC9NVz5qvLmFURDzBWO5acAX0GAOD/xS3UcgZPVPsllrAdH9CDRuu0hJZKukdN/FcYMg9u
-uMBO8ydqMTdoi5SGOa1R2hK2qJ84Q6jmVU6EYlQclLHF8SIJVGOrxis8+ufxDoGSTN9hOMWFO5ZwPy3e
-eg23zwnCxsCQ0jROMtDYCcpGSf6+UUIRjBv/AhVUEHb5L0M2RNeZoMZE9f3kGErXwntZd4p7b6zmcp/S
-UoMQ7QGoYHWwtiuvrmKwvLm+ub7aa9odfovVEtlpVTsWSmZG9j25Uy3lchiWsJBFLi1qScUlD59vXCbp
-1UzFbf4DMAqcpa7BoDFcyRmwnV7fQQWSi9TZsR1GHk7FVIOxcIhG41r00bzRqi3jSUTTP9+pcFhkkcig
-aGKqq1lQHuo7PaI4iyHG7QzTfDdWgmjWQL2zsRzmfZ5iasA6OZ43XkrQ7QtlWh2lxhNDRVfBnDyaFRbr
-xNIzklO+qdRjaFj4Gug2SjZ8DxV8e/y0TUNOTwOmxoY6Yc9hF6icpbByyb+GGgXuvduJymP4i0OHpc9/
-9tJ2hNoO02nq6zP5ytNpd1qFkp0M3g2OHfDMHd7A14NGWhNu7imX08tJniUjnTI2H0dw+Z95vwa1+4nM
-nnhzMb133HTUsoM3lYztTqj5K9nLX2M4Z/Byfi7bFHaO/cI47gp4czv/vGRLXVM4L+Iw/RcAAP//RVCU
-wlUHAAA=
-`,
-	},
-
-	"/templates/clients/ios/Sources/ProjectKit/Remote/Remote.swift": {
-		local:   "templates/clients/ios/Sources/ProjectKit/Remote/Remote.swift",
-		size:    4935,
-		modtime: 1511809058,
-		compressed: `
-H4sIAAAAAAAA/7xYbU/bSBD+nl8xRCfVloJz9+VU+Rq4loCaU0soCZVOFFUbe5JscXbN7rotjfzfT/tm
-O7Gh9N74gPHu7Dwzz8zOjNlu4SdGNgjxCKLJdHaSUWQqOtdLZdnT2ynfEMr2BF4VLM1wbLfKsjccwglP
-EVbIUBCFKSzuYUXVulhECd8MGVFrwhZcCC6GUhGhUOhD4ymcT+dwOp7MD2D+ejKDt6cvz2fw5/TqoNej
-m5wLBWe8YClRlLNeL8mIlHCJG64whvPZdPEJEwXbXg8AgDKFgpEMMlSALM05ZSqGq8s37W2JUlLOYqds
-Zl/bcikmPEURwx+z6fnYvnSBNaRO7cueTZ+JgLsCC4zh2mLOibydjL0FY6KIXrmBEVzHN/40VUG3qYOG
-hzMlKFuFsDWH9I/EbBl5ARhpDgJppOLqXHiwK+5wYOTJ2d12TMCoSUUQdgpFKVFoRChbzZTOidU9jCCi
-kj//9edf9u1sanb07Wt2QkazEXlEc2nZWxYs0ZyL+xdzz94lypwziUfBR7vl2RsAESsZw/XM0WSfN8cw
-AkazASh+i8wvHw9ArfXr7ygTklO2gqACKDL1Yn4UwuERvOc0bcZFJ8sdjJwx77QBgTOD58js3wPAryqG
-/kqQfH2X9cOBzh5KFhnK2JhZc5OQLAvuKuvMw9umf4ctQjaFIgrbjAzgKtbXWMN0sENZXujLdPyfMPFW
-G0U5exIZzhTz+CdM6BNP5uHqX/R7wVOds0rcH/vS4fLb+lw7pauGwLsCpbvFl/YlKETWuMmVvJON1krl
-b1GteQoj6F9MZ/N+p8wra4k2qLWfkGSNFzyjyf0OdnRSb0QCM07SyYpxTcobnpDsJUstEUZO17WWaonq
-PckKDPokzzOamNgPP0nOfoNkTYRENSrU8vB5fwBLLl7P5xevkaQozihmaQz9E84UMnU4v8+xX7tPl4Zf
-EyhNsHnW3Heb8AqJQAEfAiMePgT5slBrLug3Y2sDs9wJrSLytq6gulqZqh58oWode/AQthDorQGIKutQ
-d8cQKNsxd1UQkdo9GJlKBJhJ3HPKo6MQ1ZU61WeCFGUiaK5MAzFqDqJMR4l+w3Rcb4YtfWMqc6KS9Tvd
-tCLd6iMi71kCW5P4QbQkNCsEBihEGDZoqJlWhdj1Zlco5R1uWIdN6yWKwMg+HvD5AZg2lCfI9qfUXr69
-lmWewTzSqwNYCr6JDXibGmuj0xUZVuV3ogN1hHQk9w4fREsqpDroPPYjgeDdofghnr6LJ4skQSkD50ML
-sYREn+9gIcVFsboQlKmg78cDMIkawwdnf7/N91NT+//I7LLj4pu57lpf/Uj/mqTIFF1SFHqY0yuVpJER
-KIsNBjsNaTiE+XQ8jWG+phLkmhdZyp4puGX8C5AFLxQ8227dOF6W0XZrJveyfFb3M9M0P4Jer/u26Z5+
-QDw8cn82YlNfuJyoNYzATvcBrbyIod8J3Q+PI30mWHJxiZIXIsHYwA+AL3VpjjV82HUnbDZCv99BZ22R
-6Ya+U1rLg8SWfjldntEMY2P1D0O4PaPfhaHs9fCrQmZmYP+JcXX5xs3bY8xwRZQeD+o1oohfh63WMBzC
-NNffQJQz2etJJYpENae9arRwxlZe+iBVq42RrzWTaqgd3X5+0uOMn13+FpRVN9Gj1Yv50XHjM6R7Hpy7
-+bj18eFDV1O8G1pzHkbu2RE+o6WyzKJ0xLcjuK2TDaeC9vBYtth07j9OpSdAn84FVzzh2d4ouX9MT3O2
-4FcfgaaU3RzDFlaoXBayYgM7o6QZVI6ckoRIBF+CzU5Yr+eCr4TeOMs4UY0NX9IaqGFjFOZs5jR+hDXR
-91/E4LTrqhGEzQDTpVVadQKTRKGZe7IlbL2K4LOvnmUT6sIb2cSyBj+CVblmSlUHWN4JduYcb2I1SXgE
-0XNm2k8HINaA9uobjfvX3rU38xjsJYQT1GOzbWO72z7VMsowhglTO6sJz4oNq9fLKjU3KCVZYeueZw5H
-p5/HvHF3XOdmV/uM2x3DFU+H8nD5NC7X/5yhardlP/BfC6fWzEiV9K5I5UejKpQNGLSU+wnDBfpBnIdn
-h6fAlr2/AgAA//+4Y/buRxMAAA==
-`,
-	},
-
-	"/templates/clients/ios/Sources/ProjectKit/Remote/RemoteMethods.swift": {
-		local:   "templates/clients/ios/Sources/ProjectKit/Remote/RemoteMethods.swift",
-		size:    1236,
-		modtime: 1511809061,
-		compressed: `
-H4sIAAAAAAAA/8xTwY7aMBC95ytmUQ8g0eSO2m3RsqtyWGiBVurRJJPgNhln7bG2yPjfK5uwDavugaqH
-zSGWZ57fPL8nOwdvSDQIk/eQzpfrm1oicboIJe+TLIMbVSBUSKgFYwHbPVSSd3ab5qrJSPBO0FZprXRm
-WGhGHQ7NlrBYbuB2Nt9cwebTfA33t9PFGr4vv14liWxapRnulKVCsFSUJPiLkYxUBCtsFCM4yDK4txz7
-JgEAcE4LqhDSGZaSZGikTwjvI6a0lINz8QLeD52TJaRTXdkGiY33zv3ZHX4oSVNdmTul14+yZO/H4BxS
-4T2rn0gTWLOWVH0YA+/C9iOaXLSSKhgeZa7Q2JrfdQMPLLnGXBj0foWmVWTwegRvr+GbksUIXJQYvibI
-xuHgSelgDJJay5PjMoZOQFxO48N/FDl8Z0jUmvgkxPhgUUs0McmeQ1+6svcvmNwBziyOZPtAdaIN1smy
-a6QrZKspnZs5MepS5HjAB2BtMeD6HCfoZ2WM3Na42bf4LKwOeDTiL1YO+5PPsnxe+5+J9lT1xVwcdaQJ
-SZ9dMz3x1OoR9ZFncFHqzmEdTr3o5Kuy7V88utiP7jH8DgAA//+0D8Qm1AQAAA==
-`,
-	},
-
-	"/templates/clients/ios/Sources/ProjectKit/Remote/RemoteTypes.swift": {
-		local:   "templates/clients/ios/Sources/ProjectKit/Remote/RemoteTypes.swift",
-		size:    2105,
-		modtime: 1511809065,
-		compressed: `
-H4sIAAAAAAAA/9xUX2vbPhR996e4LT9+TyV5D5QwmpQF1mZt0sEYe1DsG1dFllzpam2Q9d2HbDmxW2eM
-sbzMT77/zzlXknPwn2QFwuQSRovl6kpwlDS6DS7vk/EYrlSGkKNEzQgz2Owg5/RoN6NUFWPJ6JHJjdJa
-6bEhpgl1KJot4Xa5hvlssT6D9cfFCm7mH25X8HX5cJYkvCiVJrhWVmaMuJKJc5rJHGE0wy2XPPhGq5QJ
-pk2Fr6mwGa5e+Jaiz/uEdiUywZkB52q43sNl+K9yzcrHu09N6lp16rx3DmXmfRIwLiSh3rIUzeD4Q9j7
-pNSKVKpEZ5iDfdU1R5GFNACAH0wfsibhd70rMdrTCAAc5EjgkwMifCWUhisJ91goQnAwHsOD5Eoa+B/m
-0hamHjAEtkmLCFDaogvUx7J60FB13XuweAIr0lzmF+EYsI3ALu1Q9oUJi21t+FJmsF6DUC+og1Uv5jy4
-bFm2rvOWd6jpAUyOarHcPGFKx0WI8djUkLYp9VfBt9Dbq3Mds3pSXB7Ma6Xro+P9RQttQIPe6sMnkI6t
-vzLdozj9AwUWsrS/EKAJ/wv8w7P0bFFzNPXL1CF5F93eHxHpHk2ppMHjOt1Yqp+dYakq4iSwOaZtr0mc
-0Nrg9oRj8YwRmxxuyT48rMk9ktWyfRT2yX0Zs7plaDzt+TG8tmYC3xpM82B+n77XsaVeK7kLOraahhjf
-xkALZmH2y6/wGUhbfNujTf2sjOEbgWG170SMqW+lPLG6nalR41OLiyJw+C3uJyXaX83fZw1vaDdX9GcA
-AAD//1P0DfQ5CAAA
-`,
-	},
-
-	"/templates/clients/ios/Sources/ProjectKit/Support/Keychain.swift": {
-		local:   "templates/clients/ios/Sources/ProjectKit/Support/Keychain.swift",
-		size:    2699,
-		modtime: 1508345532,
-		compressed: `
-H4sIAAAAAAAA/6RWTW/jNhC961dM91BIgOtcCwOqkdpNUWx3212lvRTFgiJHNhsuqXJIG0KQ/16QkhzJ
-Uj7c6GBI1OO84Zs3Y11dXcHtXhJIAgY71GiZQwGVVLgAYUAbByikSxL5tTbWwY3xWjAnje5XCuTeStck
-Se1LJTlwxYjgPTZ8z6SG+yQBAKitPDCHQI45yeHALHyhPbMoVifsuoO2cQbIcyDcR2C4dugGT+GSVR8Z
-8hy0VGfvw7WVVDPH9588elzulCmZSrMlNZqnlWI7WsGyZNZKtNnM9tfS9NcJeDpBms2CHyar45Xxk0Xn
-re6jf5OMUQ9jNX1J3MrapXfYrKBwVupdBt/90N2uzzXtoyvDRHqUbv8+7LvDJhtkQRP136gsoaqWxA6Y
-ajz+yZTHBVTGnrifUGN84s5qUkuXZnB/tlx5zSFSfAGKZ+/lWPdcMNLoMUuFDv71aBvIAyRW8lN4Hgs0
-wpvyH+RuyxxbQfhdQ97RrpeCOZZ6iiksvau+XwBTyhx/NUTNxugDWpJGr6BiijBLToFlFfruF4dfN6Zu
-PgTBpd6lMbdFMGMWTWl+snbaHSErIducIB8kOFOPgA2N6AnynvKPWjCHPdnHYit5GAjMNqk43a7gr7sC
-eSxhe/ie8u9s6v3aSu3Sd23kjnAF7xbd7VnZARXhK5PdosI+2Sd5W9CLvMmzGVyobMxoSeiiRGm/bWD3
-oXzAqPfjq459LcTQDW8U/Pk+iw01HBPw8oi5tJPO1Lrb3PxojEKmb+1oRBTIP8e59ZRoFwe6ds7K0juk
-YbhTvPD3ZJG8civY3Nw2NX7Gap08V5u5lv22jTEIvPPMiri/fUWhzSDvnoDRetR6c47sJriWKpmWchq/
-Ne2AbXmIGr1kyJhLXPt/OfT69APr0ijdyzabVMRhMzjTAlBzIx6HbPakiWedOHHzx+KDd6xUOFB/7Oyu
-RvkcdPDP38IGZiyQb8LX08/hS0zy3xnR0VgxtmVEzFl7Eg6b8c5g5QLtQXJ81f5uyzXnSCRLhdfqyBoK
-X4xbDFF+02qG4hE/zxKr1ZJ1lXhIkv8CAAD//72eaAiLCgAA
-`,
-	},
-
-	"/templates/clients/ios/Sources/ProjectKit/Support/Manager.swift": {
-		local:   "templates/clients/ios/Sources/ProjectKit/Support/Manager.swift",
-		size:    1952,
-		modtime: 1510468798,
-		compressed: `
-H4sIAAAAAAAA/6RVzW7bPBC86ynmuwQUPidpr0Ls/iJADqkPcU9BYNDU2mErkQK5cmAEfvdCkiVRkoMA
-rQ82rJ2d3ZldUtfX11g9aw/tIbEjQ04ypdjqjGZILYxlUKo5inReWMe4taVJJWtroqhwlq2yGX4Qpf5n
-kUomuckIrxEASO+t0hUfHwrCA0um1aGgOrgtjYLp84Shl6THzFA4bV3wJMblAl+tzaJjUPleGrkjd2c0
-nyq/X1obzZ/EesDNz86++Jq63GRaQWXS+5b+pkMu2gqF0/uqbU8cYy8dfAUJOAewGqFsnmum9GGMHAPt
-nlwmdXpfcu20T/AolvXDw8r+JjNrI/HTJJkrwDdbGiaXIMzCHB8m8Lrth3LjE1TfyumiIg4UR51pYqwx
-Pk26+njKtlc1APOGdRgaqD8PmejGHI9PZypUnWL+VsMirlOOUb9ovkFu6GaV4Is5LDe/SPFCrMHS7YgT
-rGaQqiJK8Jm8koU2O4hVvXViuIQiHuhuG7rqioiGtGdsfqdtNZ6I/KQ3QTfXoEA1pbI+I513Ay87YEsj
-LkJ43MXHEwhRHciRsnlhvWaqA6LP3zgrUyU9h4FQTjO/Q6Cnt7JXdrkYbmWvNFxdzId//8fHDjfZkytZ
-FGRSIcKUWWdIHP+1vCaHS2cG7UykO8rtnsTJgeG5C4d5bsWncrY6qzS/QvAM6xjagPFfizzg+K/jam+A
-U+9jjtH2SaXKvMze38CtdRDrWdtn3fhUcU/eO3IQF+MqvZLj8LBhPunoTW1jE4Li1atv+X2Z4NY6RbAG
-uayMfnYk01qKsS9njnlH2VyHA1+rt43MtPTdvmMOoY0tGeNLZJQwuqnvDEfH6E8AAAD//1TqkC6gBwAA
-`,
-	},
-
-	"/templates/clients/ios/Sources/ProjectKit/Support/Network.swift": {
-		local:   "templates/clients/ios/Sources/ProjectKit/Support/Network.swift",
-		size:    7864,
-		modtime: 1508345527,
-		compressed: `
-H4sIAAAAAAAA/7xZX2/juBF/96eYRYtUBhztPhRFYZw3TbNJEfQ2SZPdy2NCSyOLCE06JGWvL8l3L4aU
-ZFL+e9miyYslcv7P/DhDffz4Eb6V3AA3wGCCEjWzmEPBBQ4gVyCVBcy57fX4dKa0hQtVyZxZrmTz5m5p
-LE7PlCz4pNJ+qecZIzxeoV0o/XSLLCvZmAtul1+ZZBPUj5AJZgwIbixKA4XSoINtkJVMTtCAKqBUxhpg
-MgeW5xqNQb9/rGwJ9/enV7TmhN7zCw7SCwUuLeqCZWhSWnQbbiMRTMIYoTKYg1WQo0U95RJhzLKniSZb
-gctC6amzC9hYVRYW5RJYK0TN0FsNBeMC8wEoTcw0Wr10IpudGp8rJEMWJUpgkCkpMXOk3AAay8aCmxLz
-FC4tmFJVIncRCFScaZyjtMDojYZCqylwyS1nlsvJSi0nuBY4AGaA278YmClj+Fgg2JJZYLImFc1OmLIl
-SaNHrr3EVq8oOmlvVo0Fz+ogbg8zvPQAAEidL1hwiQZsiTBnmqvKgLHM+hivvBRKqWlbHsdQySepFnII
-7u/Sku/qd+RYW6J2EtokMA1LgWnARyp727wfumQNKMjrG6n0igSgS9WhqD2EsppuctCdZbYytX/oL2MG
-G1Pil6Gu8UorMjlrs+nbcoZ9t+2tt9P5QQLa5QyNK4CMAGC8jGuxEGxiNkXD+VuivdZUed6PHbYuubmL
-i5qjhvOahOqEiEL3LhasiSw0Dt7AjvmiX62sOzx2R9fLsdrxGunQ9d4pZEKZSiPgD8wqcpEr4jDRIocZ
-H9waw1JvSM3CsickGwyXE4HA9KSaorRD4tZK3ME1MpZcwgRnBn51QIoaRpBsTbc+HH+G3xTPG9vg6+nt
-v4dwDDeakMxyNCuz7zfXU1ZpjdKK5ZaMnzMN3ATV9U+lBLwQJFZahkvX0gXy9TV+eR6FJwzE4Rr5ZKN9
-TkR7FuzS1KvT1Vdurd3RCNJVBaaUOf2f0bZbGodqfd6pwnfqH1dFZInLX6/2walJam6VPTwIEicV0zkI
-tB6BYAQGRZH6BxQGV1amzSHw1lLvs/9C6QvilDh+a5BJNufczJjNSniusEJ3HnoAcDF9FHXNPbbF3cEi
-8kGz6T/EYghfapbuEUbxczplXP7vcGebKsMWLU68sJnmc2bd0eBdPYS7sw0Rcv46CSLUEsBoB0XS77UU
-vNi88V9o/d7QoAEc+eAEIoPQurV24a23Fnouwqg2VlJChVK2GLvmGeq+6OS82OehNXS99I0W/71ukJsA
-n2l0DRDb3SpzaSyTGcKC29JF38ww4wXH3DXHm47mG6bZlDpat8MfzfSr7SVxzkRFph3aet06p5qmW1oc
-pnOUhJmSc5QcyRZqPk8Sr9yd1VxO+htLP0rwLVnm/XjPbXnFpphILgbO2H4HJSQXAUA4MCE1kjgbwqc1
-WPijIXO99lRJbpX2/Vc9xMCn1P+vuToaUizJ84Q1QcuAuTaCMoEJsOrJIQOzkLGKRiRuKcy1aMfAj3gC
-tKpoXmgF1rChCrcrxznPcODHq8ub+V/d6HV5M//b/y0r+h2IaSwegVHZEz09cJn02z31emq4fBAoYQTf
-L6X9e/IVp0ovf2VLVdlfAtLPqeG/42b6gk25oEwzrP79YJPTi4fLq/NvAY5tTVCq0e/SsAJvlDvAE6uG
-cFSLGMALzPx74HITqNWrKfHx+t/imGZRx6cxIqXkHUDGZixzSbvR1NrODnoGwvZU06lX2hfUnz/1Izar
-QnrbXGfvL7QGdTfQbNQ4tNAJ68REd2G93Rhh+r5DLNAwR9ItFGvVzJ+qXE6SDmy0B0G7YwUod5Zp21yE
-0BRfKN3efnC5v9/aUo+PVlf4SOftivWCuZlbUydhqixDY4pKiOUAHgsmDD6CoiZwwU1dof/IucmYzqlD
-vEVTCRsWblHJzPMLLKcRw3egUQlnSlr8YbciuF9O5qgNV3IInwbu7mUILvc0WsZl+yCQGayfMjVbfkGT
-aT6zjlJysUrUWmxKvAgW5NRBUZ7OmDHfpeeLeULJ0E+tup6x5wrDhoVKPGNCjFn2dC7JEfk2I+7QntU7
-k05pBz1NtPICycPANzLe4H4XFhodOindWvLLdrz9nBZaTWuTiPmHfkrj58ru35hw1q7DQ9ALSGV5sWxa
-xrBddkkeG3RUe7x92XGl66T3+zFqizs9YdRRh+zD9ykzS5l1kO99JR9Rd5wRDCQnJztZ9Xc0qt38OjqK
-/NTtQe6smv0MYsTlG+FW4LC9KR5HxRVjVHvviW3LYB07L+lYlEy0MIoarigaPAvaamdUJ0gP+4ea9d4z
-TpMPI9g0dq7OwG5WFatBIMzNk+TQefQAN9TGxA2jZxY6Y6/Ag/xz/PkPzOzcxHsFJtTPDKEe5zrDe3jD
-GZZHcIew/95g523GiuefeAHKJPz6Lq5uXtT3nARgjEuTpNzc359e9eElVgI23/u0AlDmvFgfRkMWYWxd
-kLY47MDAdM5bwtnghqjJxsCyVv1+RCQRc7O6O91AuLp0va2/EvQ7J6Ws6U8rq6bMcsK25U5O1/ILTpnM
-+/D6unPbN82KgmfbJFLHqir73aB2RcIaI7YpdXQEH9ZiTpRzlLGJ3WCG3j06guRD13Ovr3sVawr8rdfD
-HxYl9T07PqWkWzN/COfPFbNOmZe3Xv2hy7WB0TcRu1A7r43m1AwYYBoBnysmVp/NwusEUdbDnsDCHpc0
-Gxqeo6emiTNT0xnTmK5R6oZS80m5l7S3tZ9tbOnqu7GLDc+60ci3Zc6E93jatzr6veQ+4nW99ny9mgW3
-WQmJKM2AODcHkfsYkTTXmoP2grM/7OYi+SUkCcF0EGPrPmKqpwAcSKnOh6VBCH16bXldwBoLGI1gjbCe
-qQpWCbvGwgV1VSy9/wYAAP//Z8Ayg7geAAA=
-`,
-	},
-
-	"/templates/clients/ios/Sources/ProjectKit/Support/RemoteSession.swift": {
-		local:   "templates/clients/ios/Sources/ProjectKit/Support/RemoteSession.swift",
-		size:    1946,
-		modtime: 1508358291,
-		compressed: `
-H4sIAAAAAAAA/8yUwY7TMBCG736KOSZS2NwthSJtQVQql7BZzqNk2lrr2sGeLItWeXdkN92SpikHYCGn
-yJ7xP99vz+R5Dnc75UF5QNiSIYdMDWyUpgwaC8YyUKNYCLVvrWP4YDvTICtrhODvLaFW6KGkvWW6Q/+w
-WkIBK8OTzVu7bzWFxI9oGk0OCkiWyLjIoCrXJfnWGk+LDN47Z90ihTdv4d6qRojWWba11cNJISlIwbMA
-AHhEBxyUGzKsNoqcDAXAM2yJoY8xm87U4Mh3e0pS0U+O/EzeK2uGE2N0M6gk3xTvoHNahjIzqM85JLwj
-X2OrzHYONbKMi58TcvS1I8/y4En8/3OavRD0xGQia1WuB+zjvpwYPJMgJ7a9mm/DFYXPEXfOQDJSlEHy
-otpkKQX0F1yIyxcuq5+F/Jt39mted0Xx95l7IfIcPtn6QYhao/fxfxw6fTfiamcWUFWrZZLe7NDv7lF3
-dIhvnXpEpsQTpzH50LFf0N+i1tRAARvUnsS0p0c2nSex6+iEc05x/U2HMgw98QtbccGAJJ3ESoizbbR+
-nHFyNPBGIXH2yWEEzrii0XNVruMpi3/SeUMFUASVl9WJTnI0IxvhZyfS9Pxx/2z1/9F1J9ZB8OYVmHvx
-IwAA///EBBVNmgcAAA==
-`,
-	},
-
-	"/templates/clients/ios/Sources/ProjectKit/Support/Subscription.swift": {
-		local:   "templates/clients/ios/Sources/ProjectKit/Support/Subscription.swift",
-		size:    1270,
-		modtime: 1510468856,
-		compressed: `
-H4sIAAAAAAAA/5RTwY6bMBC9+yveSj2AlCY9oyXp9tBrD0vSQ1WtDEwSt14b2eOsVlH+vQJDINlUq+WA
-hZj3Zt7zm8VigWKvPJSHxI4MOclUY6s0zVBbGMugWrEQ6rmxjvHdBlNLVtYI0YRSqwqeXagYj6H0lVNN
-++9+s8RRCABonDpIJhykgw+lR45ffWlJ7neSxjJlmJyRGs+BJSuzwzaYCqWzsq6k5+QJB6kDZdikOHaQ
-9ukZ22O+VZrJTX62jyYGS7cjfmSldbEnR8jx6ct8pO6I0wuYIw7OvEGea04ivqPE6MPl5H7QeF9keDCv
-P8o/VPEyeepJMxQzyKp1K8NX8pVsWmxSpPi8RLKJRzoVuwvS1bjrxFbWsFTGJy/tXBmOraS99EVHnsQe
-6SkFaU9XnkRxV1oGN+eyacjUyfQ6fyreXxBnvYhRQTzT9F1fgjk7M/HibNB7l4u7WzrP93ESonGWbWU1
-xpQNWfxfpB7M66rz+5u1eqwc29wcdKjvesaQ31iF0buLIMyw7r6WyG4M+kLyb7cx56ysxJDmwfEhKes+
-KR+Q+CZT3ZYg79v1mRmWYCvbz9MNyAF55If0K6yvcOzCFBbnTjhNDuOuTUqnyfmA/RMtPZknvZ33SvJ8
-EDUGRPwLAAD//xELAgb2BAAA
-`,
-	},
-
-	"/templates/clients/ios/Sources/ProjectKit/Types.swift": {
-		local:   "templates/clients/ios/Sources/ProjectKit/Types.swift",
-		size:    653,
-		modtime: 1511809070,
-		compressed: `
-H4sIAAAAAAAA/4yRz07DMAzG730KHzjvASKhaeKPxAUOewKTmGLROpPrcCDKu6OsQ1qbVSKn2D87/vwl
-Z7gTHAncPexe3o4PA5PY7rWmSul4PEU1eI5JAhpH6bpTeh/Yw2SavMHR0AhyBwBwId+ogMk+o/LPucfB
-4Tpsar2PSczBYb6sOalGdZDzWWgpTzXed2UtZTGklWTxi8TB0ZSl36/pZNhTpdjTPwVcl5GkcW6+DK7H
-40Tgowh5o3AzzdIv80o9T0bagMDT8qlyw4HZwHZ3Dn+Lr0ndaIvRiDxsQa+ERsHBI1rj2BgDf/AW3fzQ
-3wAAAP//shwnro0CAAA=
-`,
-	},
-
-	"/templates/clients/ios/Tests/ProjectKitTests/Info.plist": {
-		local:   "templates/clients/ios/Tests/ProjectKitTests/Info.plist",
-		size:    726,
-		modtime: 1507519011,
-		compressed: `
-H4sIAAAAAAAA/3ySQW+bMBiGz+VXeFYP3SE4uUzT5FIBdiom11jERNsJMXCpVbARuE359xNNJm3J6Pn7
-3kfPa3/47q1rwasaRm3NLdz4awiUqWytTXMLc7ldfYV3gYc/kTSWPwUFfatHB0QesSQGcIVQ2PetQohI
-AgRLdhJs/DVClEMAn5zrvyF0OBz8ct7yK9vNiyMSg+3V4CamR7fa+Gu/djUMPHyk/6MTeLjWlQu8K/ys
-piDeRi+mbhVRr6q1faeMy1SjrcFoHntXeHSDNk1wfUPonrJUPFAuCxby+zy8p58xOs3PePRNVS+u/NWq
-SxD9QeNchhGjBQ8flhlJrYzTj1oNlwyRpSSPZRHlnDBaJIRymWwTmi3TzKMlunLamnKY9sc3OQd/8ddL
-eV52/+nyx+PDIqKsnstGyam/IEScsKXY7skO7iS6e984T2+WdRcKXt/EeZbNXyiy9DuNZbGn2S5J+V/y
-GB0vBKP3+wm83wEAAP//HGaRL9YCAAA=
-`,
-	},
-
-	"/templates/clients/ios/Tests/ProjectKitTests/Tests.swift": {
-		local:   "templates/clients/ios/Tests/ProjectKitTests/Tests.swift",
-		size:    402,
-		modtime: 1511809073,
-		compressed: `
-H4sIAAAAAAAA/2yPwUrFMBBF9/mKEVz0geQDCg+EuhFRwSq4jfGKwTQpmXl1EfLv0jZY5TWLMNzcOZzk
-TJfBDKD2SPr2se+8QxD9MEelKDeMMQm9ds9gUdcCFvPmQTXPeVku5c6JUtYbZpqb3NaVzjAoKyKi5ZpM
-Igazi6Gl+2i/njBEQb9GF2opxQkpuXfQxylYYsjL2BwqZT58GpF0zbd0ZdDxnPun9WusE0ZvLBpGmpxF
-S/06NNpHa/xnZLnaXOtwWEll+9F/WYFJN/E77PhuTzvKwfkKLj8BAAD//0XSkcaSAQAA
-`,
-	},
-
-	"/templates/clients/ios/Tests/ProjectTests/Info.plist": {
-		local:   "templates/clients/ios/Tests/ProjectTests/Info.plist",
-		size:    726,
-		modtime: 1507519006,
-		compressed: `
-H4sIAAAAAAAA/3ySQW+bMBiGz+VXeFYP3SE4uUzT5FIBdiom11jERNsJMXCpVbARuE359xNNJm3J6Pn7
-3kfPa3/47q1rwasaRm3NLdz4awiUqWytTXMLc7ldfYV3gYc/kTSWPwUFfatHB0QesSQGcIVQ2PetQohI
-AgRLdhJs/DVClEMAn5zrvyF0OBz8ct7yK9vNiyMSg+3V4CamR7fa+Gu/djUMPHyk/6MTeLjWlQu8K/ys
-piDeRi+mbhVRr6q1faeMy1SjrcFoHntXeHSDNk1wfUPonrJUPFAuCxby+zy8p58xOs3PePRNVS+u/NWq
-SxD9QeNchhGjBQ8flhlJrYzTj1oNlwyRpSSPZRHlnDBaJIRymWwTmi3TzKMlunLamnKY9sc3OQd/8ddL
-eV52/+nyx+PDIqKsnstGyam/IEScsKXY7skO7iS6e984T2+WdRcKXt/EeZbNXyiy9DuNZbGn2S5J+V/y
-GB0vBKP3+wm83wEAAP//HGaRL9YCAAA=
-`,
-	},
-
-	"/templates/clients/ios/Tests/ProjectTests/Tests.swift": {
-		local:   "templates/clients/ios/Tests/ProjectTests/Tests.swift",
-		size:    268,
-		modtime: 1511809076,
-		compressed: `
-H4sIAAAAAAAA/3TMsarCMBTG8T1P8Q13uC59gIIgVAcRdFDBNdYjBNI05JyqEM67S0tQEM2Q4Z/vl5zx
-F2xHqOeo1rt94x0FqbZjUjWui30SnJoDsZiFEIs9e0LpOU9YdePEmNZbZoxLrgtpLBOyAYDp6m+UkrsQ
-rkNowSTH+D8rg/HwEClVpU9Vf2Ehm5b9PXzx76fPL4pkWT1sFz29sBo1zwAAAP//l57CYQwBAAA=
-`,
-	},
-
-	"/templates/clients/ios/Tests/ProjectUITests/Info.plist": {
-		local:   "templates/clients/ios/Tests/ProjectUITests/Info.plist",
-		size:    726,
-		modtime: 1507519014,
-		compressed: `
-H4sIAAAAAAAA/3ySQW+bMBiGz+VXeFYP3SE4uUzT5FIBdiom11jERNsJMXCpVbARuE359xNNJm3J6Pn7
-3kfPa3/47q1rwasaRm3NLdz4awiUqWytTXMLc7ldfYV3gYc/kTSWPwUFfatHB0QesSQGcIVQ2PetQohI
-AgRLdhJs/DVClEMAn5zrvyF0OBz8ct7yK9vNiyMSg+3V4CamR7fa+Gu/djUMPHyk/6MTeLjWlQu8K/ys
-piDeRi+mbhVRr6q1faeMy1SjrcFoHntXeHSDNk1wfUPonrJUPFAuCxby+zy8p58xOs3PePRNVS+u/NWq
-SxD9QeNchhGjBQ8flhlJrYzTj1oNlwyRpSSPZRHlnDBaJIRymWwTmi3TzKMlunLamnKY9sc3OQd/8ddL
-eV52/+nyx+PDIqKsnstGyam/IEScsKXY7skO7iS6e984T2+WdRcKXt/EeZbNXyiy9DuNZbGn2S5J+V/y
-GB0vBKP3+wm83wEAAP//HGaRL9YCAAA=
-`,
-	},
-
-	"/templates/clients/ios/Tests/ProjectUITests/UITests.swift": {
-		local:   "templates/clients/ios/Tests/ProjectUITests/UITests.swift",
-		size:    314,
-		modtime: 1511809081,
-		compressed: `
-H4sIAAAAAAAA/3SOMUsFMRCE+/yKLSzea/IDHrziiArXaKEH1y5xDwO5TchuVDjy3+UknCK61fDtzDDb
-BjeMK8HlCnZ8fHIxEKt92FFrJqw5FYXZPZOoMT6iCOxaLh06FILNQL8vkd6olPBCsFT2IKRTPp1/mKRm
-Krbzg/rEGrjSsCiVewyxFoIrLBiFDtPspnHIOQaPGhKfzjZiZf/ae5r5Z4QSltv0zn/s+H79ruhJ0bsP
-XHOkI9xMM58BAAD//99JLfU6AQAA
-`,
-	},
-
-	"/templates/clients/ios/project.yml": {
-		local:   "templates/clients/ios/project.yml",
-		size:    1427,
-		modtime: 1511809024,
-		compressed: `
-H4sIAAAAAAAA/6yTz46bMBDG7zzFHPbQHsJ2r0g9JIvbRZtAlJBKPUUuTFJrg0HYUVVZ8+4VNiQQtl1W
-6g3PN/78mz8YA3fZSaDUEHwGP0q2j+5E5DWa5AUOFT9uQq2clwUX8iZhcZb5CUMntYkaeRGFN4mpCxJ5
-zTMBGGPfI/IUai3kUQUewHqThLvHdL/YxeGS7aOQxWn0JWIbe8EREPl3H7rEeL5iHz2AkH1jy2S9YnG6
-T9l8ZfMdCJEHEK2fkpgl233I1svku8ubb76yNICHB/+Tp3l9RG0hLmjNAUD/rjAAXlUnkXEtSmmj1Ynr
-Q1kXAYhkayOqPNcZqgC27uP+WmIjGyMOUNbdCPwnrhY8e0GZ90MpKq2IVPYTC3TvA2hUOm35rA0fXvq7
-T2swu9b0LLTVjEGZE1m7Kfd2kZXGQt/tWuiYjijHCmWOMhOoXGkzcG0PBnwXtM7TG5u2nEOK/rx+2MX0
-z1LoWdO/t4ZmDe6HfjZjEnUHOWzWazzC0gh5fCdQv/+TkP7ZvstM+n3v4x5qXuCvsn5597I/C/3qGry5
-2RN29baiyf/ByPI/rkpnOX001x3/EwAA//95kzeLkwUAAA==
-`,
-	},
-
-	"/templates/clients/www/.gitignore": {
-		local:   "templates/clients/www/.gitignore",
-		size:    12,
-		modtime: 1510428372,
-		compressed: `
-H4sIAAAAAAAA/8rLT0mNz81PKc1JLQYEAAD//6UGv4gMAAAA
-`,
-	},
-
-	"/templates/cmd/root.go": {
-		local:   "templates/cmd/root.go",
-		size:    2475,
-		modtime: 1510441844,
-		compressed: `
-H4sIAAAAAAAA/5RW32/buA9/tv4KTviikIHMwfB9K5CHrpftCuxHrkX3MgyYYtO2Lrbkk+i0XS/3tx8k
-2U7W5brsJZFE8iPyQ1J0J/ONrBDytmBMtZ2xBIIlHHVuCqWr+Z/OaM4SXrbk/4zjjCW8UlT36yw37VxL
-qqVeG2uNnT8+Zh9ki7vd3JEk5KerzjvjqLLonti4rnz1/3lu1lYelWxVh5azlLGttN713OhSVW8aWQGA
-I6t0xZIt2rVxGE/XxjQsCbe+lvkGdQFhk934X+ux5nO4NoYu2wJkWRpbOKAaoZVKQ27aVuoiCxeOWgs4
-Cz5ml1H6yJJbh+cAwIdA/yZFDebS4W7HZyy5qY2l86NiUA6kBtk0L7vedsYhkDENlMbCnbEbpSu4U1TD
-xeoq81jvjK7O4evF9zY9qUbRQzArsGvMgzc8dp/UBShNaGVOga8EIN7go25MLhswFiy2hhAc2i3a7OuM
-7QJTy3vMe0LA+B+pssbQnqqy1/moJ1J4ZEleY75ZWisGBrNJmnrYYKC0okE7cPtRX2lFSjbqGwovvAy5
-ThlLRpQVWqccoSafayfS7LUxzSdpV+LsoAhmwIcd90s+g1I2DvfHYHrqeuLpc9A3obo+SSvO9lU3Ax43
-HplPOyhVgyAKLGXfkM/wP/Ns6oH0lIsEj001Az41ywziIayHSiYDvcMT4fIaWxkwwiqrrOzqvxp/8tYv
-/3gHURK9/xVsMhvUIwUXPdXGqm+SlNEQRSehFJLkWsYsTWT5zW+D4Jd8GtFe9g7tUxonRC/cw7IkvDDZ
-a6WLlQfcZ+G/L3xnzKbvRs00PQYycn8CSlQ9CjPS/HOUgfVjIAcs/xxnUn4WaqT4dLxo8aT7Y4PHN0CV
-cPC2v1gA5/548OEGB+U3qkGxV0xZspuSOCn5QhJ83398iuWiKKLKSlIt+P9+//h+eSDtybSSVL7UW5Ee
-wC71dmWxVPeCH4KyBK2F8wVExWuUxZUegwohefliAVo1cHYGh3PKx1a2lK2s0tRowW+df78PnpNzPhuA
-96HfOiz8GxqiDvV3WVbeg1ZuULSy+xxn4pf4l+6VPk8twL/A6PFbpB968TubW5+1Z/WHxA5G2TVWvhCs
-OOy+cZl9wLswhdMnA3oxjOhRLn64bui1GYy+7StpmjWebPRfH2NB+YMXkf2ndKO1KUsS47LlvSLxKlA6
-InYWiR6CqlA6zs5S5vi4S4dvDo+3nsGQfv8Vlb2X1tWyudIFBrPhcQTw5Bz6mLLEIvVWD1hi7WP5NwAA
-//9uCuDiqwkAAA==
-`,
-	},
-
-	"/templates/cmd/serve.go": {
-		local:   "templates/cmd/serve.go",
-		size:    941,
-		modtime: 1510466919,
-		compressed: `
-H4sIAAAAAAAA/3SSUWvbSBDHn3c/xbBwhxT25Bz3Egz3kJimLYRiYkof2kLWuyNpsTWr7o6cguvvXnZt
-h4SkL0Ka+c/Mb/6j0diN6RDs4KT0wxgiQyWFagdWUihCnvXMo5JSqM5zP60bG4YZGe4NrUOMIc72++aT
-GfBwmJnRq5fCNLb//jezYR3Nm5mdHzEqWUu5MxESxh0uBgf/w9+lplmEYTDk9lJ8TjgHAFU0Skux6kPk
-OahVDgD3CCeQX+x5i9YkPBzgevmxyeq7QN0cHt6S9CaBgUdc/7M2CV0ugTZEsFuPxAk4gLEWU9LAvU8g
-hU9lnj3CZUFiE7kEc5/CGJsHLcX9RHOIExVKLQ9SthNZ8OS5qmEvxX0IvBhcc+3cadnqbEMtxfm1ud2a
-LlV1s+LoqVtWKp9KaVBjfsyvLq8uy1c+YOYppgRStRTF4+bGk1vmLk+lr3rfhbCZxlO+rp9gz/iVHRxc
-vDiMBhO7BF+/p8JVNpLCjL5ZBGp9N0WsjvPfIx/ZK5Vsj4NRtYYsvDF2g+TSXgqxYsM4z24ynuJaikMt
-Rf4Lmw+G3BZvJ7KVmikNnhz+PAZjLaVoB26W0RO3lVrli3jqnrsBf6VvpDS8IjpvLGyPdvMuxqrMu/OJ
-ka7JHdf/Q5kG8ttndj2nqh6hdLrHNAZK+CV6xqghwsUp/mPCxMW3TH87HvEfNewwJh/oNCv3/x0AAP//
-2Okaoa0DAAA=
-`,
-	},
-
-	"/templates/cmd/version.go": {
-		local:   "templates/cmd/version.go",
-		size:    431,
-		modtime: 1510462019,
-		compressed: `
-H4sIAAAAAAAA/1yPsWrzMBRGZ9+nuAj+H7kYpaGbIUPIWkpJ6NR2UCTZFrUkI12ng+t3L3KTlHSVzncO
-d5DqQ7YGldMA1g0hEnIoWOOIARSstdSNR6GCW6WhWT+sVDhGyaAEOMmIJxOTDR43yO7Fmt087pzGDf5f
-BmIXnJNeT1C8JFMjIjtTrILi0IVINbLnaD0hdebq9aM7mpiZx+DbGpFt+x5TaOhTRoOdTBc0iUztR19j
-M3rFldN4d9OuUMY24et7omh9W+IERdE4Eku29/xsOizfvCyhmCuYAbIPrbfEl80+BNo5LbZan8389+Ly
-Ovhjw59qFkRDY/SY04chtxvOpkk8SWe+yFJvlExmnvH0L715Vl1MWf0dAAD//1qILJWvAQAA
-`,
-	},
-
-	"/templates/main.go": {
-		local:   "templates/main.go",
-		size:    98,
-		modtime: 1510441849,
-		compressed: `
-H4sIAAAAAAAA/xzLQQrDIBAF0HX+KSQr3egpuu0dJhObSBmVYYSCePdCDvA68Zeu7IRKBYr0puY8tv0q
-do8jcpNUyW6qR1NtmuaMb5K8VmI5dwTgMyo/3gc3sbGc8fXLPCz7gIV/AAAA//9oo9IZYgAAAA==
-`,
-	},
-
-	"/templates/pkg/auth/auth.go": {
-		local:   "templates/pkg/auth/auth.go",
-		size:    1741,
-		modtime: 1510975737,
-		compressed: `
-H4sIAAAAAAAA/4yV3W7jNhCFr8WnmOVFl1wYWu92+xdUBTZx0hpFgqIJUqCGL2h5bBOWSHVIN1aTvHtB
-Uf6RaxV7J5HD74yPztCVytdqiaA2fsWYLitLHgRLeG6Nx63nLOFIZMmFp1L51XtSZh5eDPr3K++r8Ow8
-abN0nEnGcmucB4c5oYcMeFlDXihdOlCEUNZQKeeeLM05Y38rCmrXRA/W3ipT31cqx6uVIpV7JAcZRPX0
-Dp8Ef7AWSmVqcKEM8kOdNvB541eW9D/Ka2tghWqOxGUDv9XOabPswuEE3hadsr8MfYmKkH4jXOgtQB/6
-bSx7C1Us7EGnXXa3pI/drfJ2jYbL8D18XSHco3Nh3Xna5B6eWTIehT7jh2PJQ6jfvyERQFRhr4wtNiaH
-O3xqIULP20oJ73bgZ5YQ+g0Z+KpdemZJMh5dBBU9H7AkalzAQq2xeRSfhnLAkte9xA3Z8nf8a4POC4J3
-IVxp+95VCrFxu9/EkugaXGRA6S/RwZ/RC96xJHiqF63DkGXAeUDtu3ahkyS3ZWUNGu8Cro11el8V2ot4
-dAAcWlaBRhwOSPgJPjZIlwYDM+iNNUteAQuHcAby438hZ+N7zDicnwyn8CYDHoPGe0jHae3vZfJhKoNR
-wx5Kx97GPb2AWPYmA6OLM/66NCYtg44OOyo7TsNVvIVE7rfQ3khpu3Y2e7nfpo+q2KBwce9XrGUqdpVy
-Dxduf1zC/6qcLByLtTt/aL+KornfDuCgPADXozh2fyJZIWFmbXGEdOl41Gbz5QX2ZoWF86BrIiHjpHYw
-SM3kNrO/xhq08Yebeddfs5PBcLfTXBqPqtDzkLJw+fIPH7/+9M23333/w/Dz5dXo+oa3TRyG2AS2bEcl
-9DALk1OqNYrJdFZ7HICRLFlYAt2MqDJLhFmTjdlETyE71Z2Ef5h0bLwRIZAnu1JOmyTtfmqjK2bB6H8D
-AAD//3SjxQzNBgAA
-`,
-	},
-
-	"/templates/pkg/auth/auth_test.go": {
-		local:   "templates/pkg/auth/auth_test.go",
-		size:    1698,
-		modtime: 1510975739,
-		compressed: `
-H4sIAAAAAAAA/6xUzW7bPBA8k0+xIWBA+iIoX4CeUujQpO4PiqRFrVOLImCVtS3YJp3l2nEa6N2LleTY
-ivJzSC8WTM5yhrOzXNpiZicIdsVTrcvF0hNDpJUpvGPcsNHKOOSjKfOy/mEM9aJ8SzcxOtZ6bQkK3kBb
-k541X63HK1dAjoEv8GaEIZTeRQz/tbVpHsOdVgFOMtgDmGMTa63KMczRRSHN/QxdDAcZvPlf8IrTIZGn
-cWRYtpodE2tV6WqP8zterzDwJ7RXSH3ahS9mjzErwmtZ3142vcCb9qjIfBzmJgEjeydHR7ixi+Uc08Iv
-TAKunDfVaUOZjpAj827FU0/lH8uld1J7ipaQwByKgPZyWqvQaBDmD+QXW0bC69YMJJLNFicWRPHbevUg
-E+59ayIkqv2Qwm1FvjVrR/zAzkGQ7UEwSbcogX2tqtrJTT+/hwzMsdFKArCT10Yg2kbi1BazCfmVu4ri
-WFe7Lg0XS77tmPRUw17Xl+f8fZW9B/dWhB9IPoofmOo8A8olT2BwuN5Z28vrIybUZ4S+FWx/z7Gem5+/
-AtOq4Jp0WhdBYCrdRCsl6gHkEp60qgRyZ5rG58NRfpl//TK8MAkMic7LEEo3acL5jXBcbqqkxjdLHdRo
-aQs8m1qyBSN1cNABdq7UxYkA2BOQe39u3W336NCpeUJzX02l1dgTXCZQGyU+kXUThNY3MeJ1aXpxzGum
-tGmI4J+N34v5a06TPyK9O7EZDNYPCBNoAipGdDMm3NvR7D/EXY33I8ybf/UEPTEig8M1ZFkzJb0B+RsA
-AP//cW8cJKIGAAA=
-`,
-	},
-
-	"/templates/pkg/ledger/ledger.go": {
-		local:   "templates/pkg/ledger/ledger.go",
-		size:    11314,
-		modtime: 1511806023,
-		compressed: `
-H4sIAAAAAAAA/+xabY/btrL+LP2KqZEmUq6rTdL7aXsdYLN2GvVu7Nb2ts0tgiwt0TZvZdIhaTs+2/3v
-B8MXSbblfUlycHCAGlh4TZHD4cwzzwxJLUn2J5lRKGg+ozIM2WIppIYoDFqTraaqFQatnGgyIYqeqI8F
-/qY8Eznjs5P/V4KbBimFNF2nC41fSkvGZ6ZFswVthWGwWrEcWjOm56tJkonFiSJaSHYyEwk+aoVxGK6J
-xJl7UqZ8TQqW/7KicnsuitWCK+iAnSfp003Ucj3gI3aBDPuoNtBPS5ppxmen8ITkOc0/sLwN+IeL0Nsl
-tf+1AfV60orrs43JpKB9sqAAcOtsGjsCJwu6O+Pr4eAtSJoJmXvRQ/OrL/RrseI5wKFo2wO40DDFPq3Y
-m4IulnrbJZpAB/54j+6IWtc3rTgMT04gzSnXbMqoBKZAzykwrqmckoyCnhMNG0mWChZUz0WuYKVoDloA
-s8O2wDRdqCREk+zIKoVch4Fr36bdKAbr06pxvF3SqvnGKHW2XBb31WgqJJDlstgyPnPwgzUpVlSBFigM
-Jax4TqXtgpoasVsjyKlezljXGxtRaZY79WLXNmYLGmWSEk3zNixEjovODRYSfBa7dQyWmgmuIKcqk2xC
-FQjXglpbjzkFfFel5SrTOHv3FTxVH4uk+8pJG2VzuiA1Ybiy0S8XDkfeNUoLSR18VBJmgivtx3bgKjwf
-9s7GPRifvbroQfoa+oMx9H5PR+ORG4Sx40EPikpGCvh5mL49G76D/+29a4cBy8HEIQ7tX15ctMPAxwWs
-iczmREbfv4j3nwMG+qTeihYzZlOaLJblE+j2Xp9dXowhW0lJuf5QdgnjH+5ewAfGc/rp4cuAy376y2Xv
-rtXUdEj73d7vt+iAUw/6O00Ryx8wvlRkX4p/EP9wZcDRpxvHAJLqleQKCHC6cSCrBw6OTMLpimfVICOu
-Fr/tEqgOlzE8dZKuw0DCaccJvg6DIJ+cWkLyg5Luq3YYBGnXt5sp6yyAj5GRMPhPdx9bPvAdrISSwdph
-cBMGbAoySbvQ6UCrhQoF9qfxJrLhr/8dxcnIhGwUmyEyMcNBJlRK6BgkJm+JVHNSmMXHYWANB4+lizeT
-NUpzuoCyfAMztqbc0ngCqXb0rYCSbA5KE00XlGtDP8KJOIXbMgkQbgkEGLfeEjKnMkER40F3cAqv2Wwl
-KYiVBgIbskXJYsE06DlD9T6umDSzgpBA+VTIjALTztVmMZHNO5bMDnzcBiJnCpIkKVnw+sY4Xq0KQ0mY
-TaT9ZRvRaPjtrOqU+LXMuXbC2Lqs6vlNBzgrrOe80Z089JXYYAqWBmYVpJKaRKtqkiRW9IHMmk5UyuZp
-cjqlEnCy5LwQiiJS0LmmpU8/6Sg20syiHdwN1NxasdsoIzx6LJMz9GzabcNjRKL58vAuf5h/bHZAKfkE
-6qur1B46oHUwrVGeR7vtbZCxjwJnI6NKT8oo/uFuU9yE+8awaH/DMHFUeCdFYRLMmkpVpiwP/LTrYOVG
-VSmymTluA9Ct3r4Kg2DUu+idj6voYXm7jB1ThJmwCYOgVjfhz9/e9IY9YDl04NFzbBgMu70hvHpn46zb
-G51fYSj+jaGHYqgxam2vsdCkgA4UlO9NusMCtttLeFafYaSJ1IbId0f+8ex9knarfj2eN/VqmPK753bk
-MdC/pjqb1zLmkswYx6oOCqY0iKnzmXJwN/3L3FtCfsqk0vCUcd2GCZ0KSdMuPL1vQERhENhRDgJYhZZt
-52LFNYBrI1NNpW9ybQXRVKHdoGpjuYLq88d7X3U7E/oHPgjjMAxOTmBo+RuemAU9wQwzoTAzha7ErMTh
-mfGiXXDHYuSvv9xvh5nHj+Fp2eHZIYSmC41IE3IatfrCeVE5L9AcJjQjK1VpsSEK5bbiRuAZxa0fNYJq
-LyXtM8pQbKIrxyjng8v+OHoaQ404XO1ouaN0NDLIVZWyYx+0NTDfnuSOLN98wxS1N3sTFHQK365b7Zqk
-W9bdpZrKBeO0ZEfErAWEW9B97FGRbOTlNBilpNQds0DJqqUKyKxwkb5Nx9BkNY/XA4t1OoAbnp6UfTEU
-G3VoO86KZvb5YtM7m2HN+jnmx0xZrh8zADaUVOA9waZV2zEdjznoPh7CJLeX9s763R1/vbiqKOrQNTs0
-hCkCaKHMXniPoTpQss5/wXM4OYGUZ678VGIXgbiNZzwrVjnNv46zjAk+21dzsYEF2ZYVPf2EXG/XZ8pw
-Q7I1D7pF1/znzfBNI8Hd4UL8L4h2OOj2qCudWiLsfxpdG8PZCGpZo/0VZnp5fKYqF+HIEldlHdMMLqsZ
-PK5Gx3s07jaaaVfBVIoFOOXuXSiyvDkymmwYBsHB4nZKxR1SC4PA0tqj748v15UDd1eVtwDcQ9OBMvo2
-jx3W9yY9ivkHlaZl9Y6Vw25JaF3H8sOysKkuPFAENTHFSFmJMiw/TdH9L6k/qxqSmcIx3C8amasUmS0P
-D4GncIg1/2nHPkm5VduhLt5R3BWIX0H3qmr3Qr1G96CeN0ShV9HQVY34Euu1Wo+fJV0zscIp6tXly+Zy
-vcb+jSL2avlGGYfqTUmh6B0lecprRbmrljdMzxtPYRQ1lXra3anSU1QGOyOP+AL4wbtTwrfoZAzV0VIy
-rqdR6/pbddNqu4hRyU+C1aZqQ6vdiuPP2dcevV+A4xvbs/676NHz+KoNhG//3sh+HSJpQKXN4/sHrIrx
-WeHP2ncwma2UFgt/QHjB/qTuBC5Gaaw6MMQqozwvrA4L731WaG84quPC6rDPnex+5pFf01lvPjmtOcmc
-qt7n3O/okZ8/7TNuS/JJVS0dHPLVgPbZONs/33WBz9CTLuwdlZSbflMTuH7VzYwHpd1a+2CwNFjbhHvK
-w89EiMK0lPRpW3y6wo9PwTZTwU4brt5/zK2fW4OZYCnpkkh3IcSxxS2nBkxJSY6VhWFRtKCDSiQ9A8bg
-4hw1w2Xe4rsDDjfcX9K+yTv+0opwWBKpnHo/jQb978zNL80rMJs7K9vBqW5Owam9yUM5S4EINRdcky2s
-ExjPaVUpGieaKzzTA0da2Qo2tCjwG9vO7ZUdysNZ39Yv7lSDQQzS1v5yML7dKJ6H9kxROwMpe0maWcxX
-B1ym0UJ7bfB+yHxl79Nn79u1wc9P37sgsTNYw/ekLJkK125PZAx23G0QUYCOWGHk0xzNqiubjqhuMIjh
-VSfkunS+MUgZVH7LR4xXzdWkrF1C6e2SZaQotu46yjPmpBATjD17PYUbSg0LwrgmDLmWw4qzjyvqb54Z
-lXavBuYelglur199yJoJq5DNJ1DepppgskEUBr6csiHr+aOMO3Ntjh9zYTQkm7dUKTKjYYCkYp6Ut75h
-sB+23iSkljS43W9iLNbv5hZEZ3OqgOU7ZscOsZEQefjNiTK7hcgCcgdXTVz6sFLjrmP0g91S47l67fTH
-cnNFzV+HzK1lf5NMU08eBDKx3CKEEMbu5tjbGKFiNoJoYg1sio6wO8Oc5fyJuaojBbLk1jqowQ1mui/y
-Q9of9YZjSPvjQXnZfuiKGM3+69nFZW8E0aPnbXj0og2Pvo9h2BtfDvtp/8eaJ9HmDWb2/8d7tVGjwY1N
-D8ulhkrJk9yHsrQ1i+x9oll0dbg6fxNfu7KJobayRy9iGPThfNB/fZGej6E7gP5g/Cbt/9iEnHtraNHR
-pQXVFDboNX8d7oxusuA/qBQ0NxeqZSKSdCHWFiPm7MG+fpLTTw1wsPLvhYfEvX5TXmSbXaeF7xG1ctOr
-0c7/ZiDdByKG9tCUO0c4bjU7i+n2Lnrj3tEjeMc7TrEHKVFWee6FmJ0iwzKvKNOPTR4NzGtGRbr2cs+X
-83BDSX8f3jX06k+o9hlWHyHWY2Qa/2cXaNY9zQXarZFYe//jki/cGyA+v6zjuwTgBti9+VXP/Hd5/I5t
-fP0axXQ9O8ihje51mty60bJqJ/5lNifLNey+ybabXb+sgmzw1l71WKp85N7H1JYH7z7Wdx31wjNV/0cl
-bp+XQmoFmznVcyoBl7CUVFGubfGFDOyc0KCilVLbBLmZzEusyblY4EarhEtJ57bMd5r8LNmaaBpa6Q17
-Y/824Y4pvvHnSefC1rzRxza07q7YWvGeuRreefVZu3mOGiyPCStfaa1bn7MCV2xWWUVMZcs9E9bhGd6E
-/wwAAP//V+xIcDIsAAA=
-`,
-	},
-
-	"/templates/pkg/ledger/ledger_test.go": {
-		local:   "templates/pkg/ledger/ledger_test.go",
-		size:    5743,
-		modtime: 1511806075,
-		compressed: `
-H4sIAAAAAAAA/7xXbW/bvhF/LX2Kq7CkUmvIjde9yaAXTeyiBpqkczwUW1EktEg7RGRSIak0WervPhwl
-OZQs5+HftAFaS0feA3+/u+MpJ+klWTDIGF0w5ft8mUtlIPS9gBJDZkSzvr7KAt8LMrnAH6nxf8O04cIK
-DF+ywPe9MwgW3FwUsziVy37GZ/38KoB+H3KpzUIxDTOSXjJB/cj3r4lCJ2jmJDdcCg34Vz2XCx/SVBbC
-2IUjmV5W7+XihGkjFZvyJQOMIMYntDwvRApTps0R4SJcwpsq1Pgogjvf9/p9OGWmyH2PznrAlIL9BPRV
-Fp/kTIRBHWzQg6DQTCXr6OlMkCVLSqTO0CponS0lZQnlmswyFkS+x+fW5qsEBM/gzve8TC7ij8SQLGRK
-Rb63spvO1r7pLB7dsDQ8TS/YkkT/fIK+C1tSg3Y3PNgHOluVZ5wUwvdSSRm6WMaTQoRRuTJlRFH5Q2wJ
-43w4OfkC0w8Hn0egWCoV7VW/Z1xQdnP+lAh9T+p4dMNNiCFE/sr3Uym0sTwOiSHT25xBAsFSppcxKYkN
-fN+g2OEatFFFatDLeAjlnzaKi4XvHZMlawgOFSOGUXDywTuSlM85o45sVeVIyOGN4yqCMWXC8PnteBhG
-lVEAuAPFTKEE8Hg8hNUW3Q95nqEip5VmBFbXKiXA6VbN2isicu937bUB2IPe8XBhWmLQg+XGwTH9PR7X
-KCVQ7UXhGqZkrfgATvpYmtFVQbJwCY2VmZQ2I1zEXmH6jYfw86fveTy2rFmZfaqkdVB2oX6p1tax2cWj
-dnhY6l8VN2xikzQ09xU/tUfGVoNp1uwhXGDSO6I7jGcfgmNiLoiAA6mUVMEKD5Pi1mP2o/Kwy0UPnBKM
-7J7YBhFWL6cpEeEuusWqq5rCfoKVFI+UCjeLyKBcqkaTsNVRI8ZF+ehsnofBjsa1HexX6929em/TEGZi
-AkHQssApcA1smZvboKlQERGP9X+ZkmHUUqzyB7X/x5RsKddEbdNeJ2hT3W38ibXUYHrCCP1VosfDfXDc
-xOPhM1jGAF6W5EYoTmmh2TbZb68t22+vg56rV3JvLbpg/Tun5Ll14UZj0y1Zl8QXUmR1XXQB1gjoj9SH
-G+x9C0uaCbgt75IEdq4h3LmOmmCuFXtNO51e1+1sXalNb6Ja39EQ7uioWaVtQ/cV4E43SWPfYd20HymU
-fxVM3ZbM6G7uLzj6uIVv3xsJoJlBIqx+eO573uno8+hwCoRSRs847QH+w+EQL+vyqWdvGfA97+Pk5Kga
-F/D166fRZIS3XwJ/20PByWQ4msDBf0qF4ej08LyRK71WZUa+N5cKNDPxMbsxVRfpTl0MvZFYnlefMQGS
-50zQsBI4BeMkH+o/PfkyJmpzEe4dtKivfdulcIci+a7ORrk6lD21WqsidDV/ibIGX3+NrpepbheXsbgm
-GadlRm4Ao1i69wAGnMLLHrB1nr3WgUZKufEeyqxYCt15Rhv74Nf4WxM4l/LlDzfYergpfu+0+10ne1Ur
-e7AVbU3sp98ulZuw1T3/0GzWmhq3XAKvG7teb9wIVVbUiAw2IBm0MHlkqunAaeAOMAMHisGj5D8GxuDl
-0Bi4cGxedINGgg1Zxp475Wym1/OhbIyCZRC/M8O2wrglj9DCK3S4Zf5GCIQ0dvauLZQfn6E9EDFk84r6
-yEx6sbWU8aY+K+OwpyViwZrjBYZQf2ihPXgXrHpt2V6HbNAh+3uH7L2VrexZN0nGyNpMNvvDk8hqsYVg
-r3xvzpW2o9MAk0Djk4UrdD/he7Br9/XQWDsU17d+5iSCGhUxndNIef/pjWnE1VsbROFUGpLh9n+0LJn7
-hcrOenvDwCeivyh2zWVhvRpVtIfji80dQdsETn24OCeZbuu3l5vK1am+vfteffm6wj0r7IaIKAa0yDOe
-4qQdVG2o3wfrLCcLVpOdwPuS64ep3rV0Cjoe/jbGkwTebTkOLm3A6uLeBe1Fx5at3HRQ21oNqk7y/wAA
-AP//qyPJO28WAAA=
-`,
-	},
-
-	"/templates/state/postgres/postgres.go": {
-		local:   "templates/state/postgres/postgres.go",
-		size:    2671,
-		modtime: 1511805687,
-		compressed: `
-H4sIAAAAAAAA/7xW32/bNhB+Fv+KKx8GKTOkYVhfNvghbWTMW+JljoOtCIKUEs8yF4lUj1SSwvD/PlA/
-4jjO2nRI+yTZ9933fXc8H12L/FoUCLWxriC0jKmqNuQgZAEvTcFZwK0jpQvrX52q0D+XleOMBbxQbtVk
-cW6q5J/KKDI6sR/KO74b0sKthM4MkaFkvY5nosLNJqmvi6REWSA9D2+dcOhlr+AhulRZUn/gkCT3VUAm
-8mvUkkWMuY81QiW0KJDAOmpyB2sWyAwOvNX46A3bMJYkMMPbM68AhK4hbUFs+doAgarqEivUTjhldMyW
-jc7v88J8WUAl6ouuX5fdI4LWdtwzrFlwIwgEFRYueggL1BLyZXHBzy0Sv4RXY+DcQ4MWNwZR16hl6D+N
-gDcWacy/f5ARsWCzZTkV1t4akp9nqnvkwLbN3GU8Ek5kwiK/hPE9Y2mKeCKcKEN+oqxVugDZ40CLCnnH
-8aSwzDxikN3Sj4BbW1ZG4lgqK7KyZZHZCJAIfh5De2RvjdaYu5AP58NH0A9p/JtRelABHkVtET751Ri0
-Kh85R6LOpczik8a69A7zsJvJ+CxfYSWi3dh7FgRv5+nhIoXF4ZvjFKYTmP2xgPTv6dniDHym/+kEgZAS
-5ZWSYJGUKOF0Pj05nL+D39N3Ix9fkql8uGmUbBlm58fHbcSZp7+/VlrCjaB8JSj88fXraDd8Ppv+eZ6G
-Pe8IWpoR+KyIBUH0y6edKy3x7krkuWm0u8JKqPJ5hQwpjz1DZ6jFdHRPev9ia6JxK2euUb+cvY5usPfT
-D7vu3kcs6FYCfNfvkbXMNv3WOGzcql8PrNsHYQUHPS6COQrpIQsvEXZCw2IIu5d2tg1FfjR7Hc5HsKxc
-nPrAMuQz42A67B6UPPLi+2J/kXK4VetLnx7BILSr38o+UP2soi+34/xExRN0+aqH2XCpyDpQ2o1ALF23
-gbviD7q9OCCf6IJW5f9qQ9vzjnavB3vCX0d3Yij1Mx92k//1tbuj74tWGh4LDZv+G1g5whK3XvYP4IvH
-bl/iV2WdoY8TQ88+55cdMC//nyWOQDjw/5Tiharw5VqdJHBK6kY47E3dkqhTIn+LDZz3ze1vvXF7ZXre
-mZmbW9vef71q5yolmmNuSM6Mm5hGy+Hm79P723AP9lymHoJEbMP+DQAA//+YovbkbwoAAA==
-`,
-	},
-
-	"/templates/state/state.go": {
-		local:   "templates/state/state.go",
-		size:    1585,
-		modtime: 1511805627,
-		compressed: `
-H4sIAAAAAAAA/3xUzW7jOAw+W0/BGljUXgT2vUAO3W2z20tn0Ckwh6IH1aEdIbZkUEwzwaDvPqBkO0mT
-5mIl4vdDfpbV62qtGwTPmlEp0/WOGDKVpEjkyKcqSVvXyMKmw1SpJG1cq21TOGrKX2VFu55d+RbWVOVK
-vWsSgbKEe6InrBwtHx0v3MYuoUNtPfAKgUIBttqDdQy1lAuVnFLmEDspHnGbpbG4p6T5sdODfdetOWNk
-PJhYO3QZ4WdNBnyay1RlCT8kIxIl0TWWkWpdIfBKM2xJ9x50206wse4LxbseT/bht0puN7yK+yq5rSq3
-sTz8/VCRtUccM59QL6X27NZoM5YneCZjmxyy+GMWh8pV8pMM4x6to9PDHYzAY36gSQdlCUddXZx9QMJD
-+W2Y+BP3sP0FcrUa6j6rDXkWwAx0Ldhpkr9HzH6YMHncPZnkgHGWsHB032nTZijPS6QY2WBjLOwRvfZ+
-K+fjAvsOW9zTT7uMCSf/G8+OdgtHFwY6l4DQvpSfgWaQr7V4Nh2e6288XP/oao12CfXGVlmn+5co8Dp6
-D2dRSR2esDGekbK1scvJ6m2QGKRyebnDnn8R5CvMR5D4BqlH3AbpY6mqbuDLJkS302ukGbg13Eyag4lK
-TA1Xbi2wpHVNsdCs2zpLA1vuB0c3U7PXf/nrcIfQMBMu0xmIUK6SD5UQ8oYsBL+sqpuQmNxroynMQ/Ew
-szGA8NXc23Affh+PShT0oC1gLOFyOkhFDOUTRw7dGEFcZTbhhNcYIgiE4j+0SJpxQa6b2C+vbzvGzNh8
-NuLusNablv91nvOQl8hczcGaNsTWa2uqDImOQojemRhLDH8CAAD//1h33BIxBgAA
-`,
-	},
-
-	"/templates/state/types.go": {
-		local:   "templates/state/types.go",
-		size:    1297,
-		modtime: 1511805612,
-		compressed: `
-H4sIAAAAAAAA/3RUzW7bPBA8m0+x8Mn68EF5Ah2MWEWEIj+QnVPRA02uFLYSKZCruIHhdy9IUT92Ut24
-3NkdzQzYcfGb1wiOOCFjqu2MJViTanHNmDDaEWzYaiuE6TXtOPHDR4eQwdr2OtWc3rhOz+f0ibd4uaR8
-6FuzhLG7O4gwsNhZdKjJAddT1Rx/oaCUkZ84Fh3ZXhCc2cqPBP85skrXbJW3XDXLwgt37mSsnAqF2wpS
-7whHYxq22hOn3gGMw4czWz0ZieyyZOiuKIIwTYOClNFgqqnnmqlbUC3R9Q05+PEzXnpuNRa6Mn7PEhY5
-RcafFC6RS7Rwy9kLXubbXV6up9ZHI9FyMqF78WWwfnze5eX28Lzo3vcd2t7hF93715e8fN372YNtXp8b
-z/jRkeWCwl0UIrTNIhQyjhzduO+tC+yUF+TeIieUAD5b6UG1yFaPRqpKoVzUBltyWV8zAIsN94a4N9XB
-EemEqIFOJrAYrQmwmdE3a9piTsfBBIrj8bvSi+Owd3RtubvjtdI1KF0Z2wYOcdvUPG88GOJNlCH89QN3
-T/iHQmEI5QN3LxbflendHFNLxe4661rGyg3DezPw0AIZq3otYKPgPy9CAoVETar6KHabJKLCgDNYpN5q
-UGkh4XIL23Zd4zFqlCqBCAv9Gah/gQaHN2IwWmlKAigan0G8+Brs7d6IIRX/Q/spCokXVKVjbjKIvb44
-BSebgF6faU0M/SyJf7VmUSZBbt+1C/sbAAD//yZSfkYRBQAA
-`,
-	},
-
-	"/": {
-		isDir: true,
-		local: "",
-	},
-
-	"/templates": {
-		isDir: true,
-		local: "templates",
-	},
-
-	"/templates/api": {
-		isDir: true,
-		local: "templates/api",
-	},
-
-	"/templates/api/server": {
-		isDir: true,
-		local: "templates/api/server",
-	},
-
-	"/templates/clients": {
-		isDir: true,
-		local: "templates/clients",
-	},
-
-	"/templates/clients/ios": {
-		isDir: true,
-		local: "templates/clients/ios",
-	},
-
-	"/templates/clients/ios/Sources": {
-		isDir: true,
-		local: "templates/clients/ios/Sources",
-	},
-
-	"/templates/clients/ios/Sources/Project": {
-		isDir: true,
-		local: "templates/clients/ios/Sources/Project",
-	},
-
-	"/templates/clients/ios/Sources/Project/Assets.xcassets": {
-		isDir: true,
-		local: "templates/clients/ios/Sources/Project/Assets.xcassets",
-	},
-
-	"/templates/clients/ios/Sources/Project/Assets.xcassets/AppIcon.appiconset": {
-		isDir: true,
-		local: "templates/clients/ios/Sources/Project/Assets.xcassets/AppIcon.appiconset",
-	},
-
-	"/templates/clients/ios/Sources/Project/Base.lproj": {
-		isDir: true,
-		local: "templates/clients/ios/Sources/Project/Base.lproj",
-	},
-
-	"/templates/clients/ios/Sources/Project/Cells": {
-		isDir: true,
-		local: "templates/clients/ios/Sources/Project/Cells",
-	},
-
-	"/templates/clients/ios/Sources/Project/Extensions": {
-		isDir: true,
-		local: "templates/clients/ios/Sources/Project/Extensions",
-	},
-
-	"/templates/clients/ios/Sources/Project/Views": {
-		isDir: true,
-		local: "templates/clients/ios/Sources/Project/Views",
-	},
-
-	"/templates/clients/ios/Sources/ProjectKit": {
-		isDir: true,
-		local: "templates/clients/ios/Sources/ProjectKit",
-	},
-
-	"/templates/clients/ios/Sources/ProjectKit/Extensions": {
-		isDir: true,
-		local: "templates/clients/ios/Sources/ProjectKit/Extensions",
-	},
-
-	"/templates/clients/ios/Sources/ProjectKit/GraphQL": {
-		isDir: true,
-		local: "templates/clients/ios/Sources/ProjectKit/GraphQL",
-	},
-
-	"/templates/clients/ios/Sources/ProjectKit/Remote": {
-		isDir: true,
-		local: "templates/clients/ios/Sources/ProjectKit/Remote",
-	},
-
-	"/templates/clients/ios/Sources/ProjectKit/Support": {
-		isDir: true,
-		local: "templates/clients/ios/Sources/ProjectKit/Support",
-	},
-
-	"/templates/clients/ios/Tests": {
-		isDir: true,
-		local: "templates/clients/ios/Tests",
-	},
-
-	"/templates/clients/ios/Tests/ProjectKitTests": {
-		isDir: true,
-		local: "templates/clients/ios/Tests/ProjectKitTests",
-	},
-
-	"/templates/clients/ios/Tests/ProjectTests": {
-		isDir: true,
-		local: "templates/clients/ios/Tests/ProjectTests",
-	},
-
-	"/templates/clients/ios/Tests/ProjectUITests": {
-		isDir: true,
-		local: "templates/clients/ios/Tests/ProjectUITests",
-	},
-
-	"/templates/clients/www": {
-		isDir: true,
-		local: "templates/clients/www",
-	},
-
-	"/templates/cmd": {
-		isDir: true,
-		local: "templates/cmd",
-	},
-
-	"/templates/pkg": {
-		isDir: true,
-		local: "templates/pkg",
-	},
-
-	"/templates/pkg/auth": {
-		isDir: true,
-		local: "templates/pkg/auth",
-	},
-
-	"/templates/pkg/ledger": {
-		isDir: true,
-		local: "templates/pkg/ledger",
-	},
-
-	"/templates/state": {
-		isDir: true,
-		local: "templates/state",
-	},
-
-	"/templates/state/postgres": {
-		isDir: true,
-		local: "templates/state/postgres",
-	},
-}
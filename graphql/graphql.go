@@ -22,8 +22,9 @@ type Schema struct {
 	Inputs          []*InputObject
 	Scalars         []*Scalar
 
-	Queries   []*Field
-	Mutations []*Field
+	Queries       []*Field
+	Mutations     []*Field
+	Subscriptions []*Field
 }
 
 func (s *Schema) Resolve(name string) common.Type {
@@ -50,10 +51,12 @@ type Object struct {
 }
 
 type Interface struct {
-	Name          string
-	PossibleTypes []*Object
-	Fields        FieldList
-	Desc          string
+	Name           string
+	Interfaces     []*Interface
+	PossibleTypes  []*Object
+	Fields         FieldList
+	Desc           string
+	interfaceNames []string
 }
 
 type Union struct {
@@ -198,18 +201,30 @@ func (s *Schema) Parse(data []byte) error {
 		s.EntryPoints[key] = t
 	}
 
-	for _, obj := range s.Objects {
-		obj.Interfaces = make([]*Interface, len(obj.interfaceNames))
-		for i, intfName := range obj.interfaceNames {
-			t, ok := s.Types[intfName]
-			if !ok {
-				return errors.Errorf("interface %q not found", intfName)
+	for _, intf := range s.Interfaces {
+		intf.Interfaces = make([]*Interface, len(intf.interfaceNames))
+		for i, intfName := range intf.interfaceNames {
+			if intfName == intf.Name {
+				return errors.Errorf("interface %q cannot implement itself", intfName)
 			}
-			intf, ok := t.(*Interface)
-			if !ok {
-				return errors.Errorf("type %q is not an interface", intfName)
+			t, err := resolveInterface(s, intfName)
+			if err != nil {
+				return err
 			}
-			obj.Interfaces[i] = intf
+			intf.Interfaces[i] = t
+		}
+	}
+
+	for _, obj := range s.Objects {
+		obj.Interfaces = make([]*Interface, 0, len(obj.interfaceNames))
+		for _, intfName := range obj.interfaceNames {
+			intf, err := resolveInterface(s, intfName)
+			if err != nil {
+				return err
+			}
+			obj.Interfaces = appendInterfaces(obj.Interfaces, intf, map[string]bool{})
+		}
+		for _, intf := range obj.Interfaces {
 			intf.PossibleTypes = append(intf.PossibleTypes, obj)
 		}
 	}
@@ -247,6 +262,10 @@ func (s *Schema) Parse(data []byte) error {
 			s.Mutations = append(s.Mutations, obj.Fields...)
 			s.Objects = append(s.Objects[:i], s.Objects[i+1:]...)
 		}
+		if obj.Name == s.EntryPointNames["subscription"] {
+			s.Subscriptions = append(s.Subscriptions, obj.Fields...)
+			s.Objects = append(s.Objects[:i], s.Objects[i+1:]...)
+		}
 	}
 	return nil
 }
@@ -273,6 +292,41 @@ func resolveNamedType(s *Schema, t NamedType) error {
 	return nil
 }
 
+func resolveInterface(s *Schema, name string) (*Interface, error) {
+	t, ok := s.Types[name]
+	if !ok {
+		return nil, errors.Errorf("interface %q not found", name)
+	}
+	intf, ok := t.(*Interface)
+	if !ok {
+		return nil, errors.Errorf("type %q is not an interface", name)
+	}
+	return intf, nil
+}
+
+// appendInterfaces appends intf and every interface it transitively implements
+// to list, skipping interfaces that are already present.
+func appendInterfaces(list []*Interface, intf *Interface, seen map[string]bool) []*Interface {
+	if seen[intf.Name] {
+		return list
+	}
+	seen[intf.Name] = true
+	found := false
+	for _, v := range list {
+		if v == intf {
+			found = true
+			break
+		}
+	}
+	if !found {
+		list = append(list, intf)
+	}
+	for _, v := range intf.Interfaces {
+		list = appendInterfaces(list, v, seen)
+	}
+	return list
+}
+
 func resolveField(s *Schema, f *Field) error {
 	t, err := common.ResolveType(f.Type, s.Resolve)
 	if err != nil {
@@ -373,15 +427,7 @@ func parseSchema(s *Schema, l *common.Lexer) {
 func parseObjectDecl(l *common.Lexer) *Object {
 	o := &Object{}
 	o.Name = l.ConsumeIdent()
-	if l.Peek() == scanner.Ident {
-		l.ConsumeKeyword("implements")
-		for {
-			o.interfaceNames = append(o.interfaceNames, l.ConsumeIdent())
-			if l.Peek() == '{' {
-				break
-			}
-		}
-	}
+	o.interfaceNames = parseImplements(l)
 	l.ConsumeToken('{')
 	o.Fields = parseFields(l)
 	l.ConsumeToken('}')
@@ -391,12 +437,35 @@ func parseObjectDecl(l *common.Lexer) *Object {
 func parseInterfaceDecl(l *common.Lexer) *Interface {
 	i := &Interface{}
 	i.Name = l.ConsumeIdent()
+	i.interfaceNames = parseImplements(l)
 	l.ConsumeToken('{')
 	i.Fields = parseFields(l)
 	l.ConsumeToken('}')
 	return i
 }
 
+// parseImplements parses an optional implements clause. Both the current
+// `implements A & B` form and the legacy space separated `implements A B` form
+// are accepted.
+func parseImplements(l *common.Lexer) []string {
+	var names []string
+	if l.Peek() != scanner.Ident {
+		return names
+	}
+	l.ConsumeKeyword("implements")
+	if l.Peek() == '&' {
+		l.ConsumeToken('&')
+	}
+	names = append(names, l.ConsumeIdent())
+	for l.Peek() == '&' || l.Peek() == scanner.Ident {
+		if l.Peek() == '&' {
+			l.ConsumeToken('&')
+		}
+		names = append(names, l.ConsumeIdent())
+	}
+	return names
+}
+
 func parseUnionDecl(l *common.Lexer) *Union {
 	union := &Union{}
 	union.Name = l.ConsumeIdent()
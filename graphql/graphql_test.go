@@ -0,0 +1,122 @@
+package graphql
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseImplements(t *testing.T) {
+	const interfaces = `
+interface A { a: ID }
+interface B { b: ID }
+`
+	tests := []struct {
+		name   string
+		schema string
+		want   map[string][]string // Interfaces by type name
+		err    bool
+	}{
+		{
+			name:   "ampersand",
+			schema: interfaces + `type T implements A & B { a: ID b: ID }`,
+			want:   map[string][]string{"T": {"A", "B"}},
+		},
+		{
+			name:   "leading ampersand",
+			schema: interfaces + `type T implements & A & B { a: ID b: ID }`,
+			want:   map[string][]string{"T": {"A", "B"}},
+		},
+		{
+			name:   "space separated",
+			schema: interfaces + `type T implements A B { a: ID b: ID }`,
+			want:   map[string][]string{"T": {"A", "B"}},
+		},
+		{
+			name:   "none",
+			schema: `type T { a: ID }`,
+			want:   map[string][]string{"T": nil},
+		},
+		{
+			name:   "interface implementing interface",
+			schema: `interface A { a: ID } interface B implements A { a: ID b: ID } type T implements B { a: ID b: ID }`,
+			want:   map[string][]string{"B": {"A"}, "T": {"B", "A"}},
+		},
+		{
+			name:   "transitive interface listed",
+			schema: `interface A { a: ID } interface B implements A { a: ID b: ID } type T implements A & B { a: ID b: ID }`,
+			want:   map[string][]string{"B": {"A"}, "T": {"A", "B"}},
+		},
+		{
+			name:   "interface implementing itself",
+			schema: `interface A implements A { a: ID }`,
+			err:    true,
+		},
+		{
+			name:   "unknown interface",
+			schema: `type T implements A { a: ID }`,
+			err:    true,
+		},
+		{
+			name:   "object implemented",
+			schema: `type A { a: ID } type T implements A { a: ID }`,
+			err:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New()
+			err := s.Parse([]byte(tt.schema))
+			if tt.err {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			for name, want := range tt.want {
+				var got []string
+				switch typ := s.Types[name].(type) {
+				case *Object:
+					got = interfaceNames(typ.Interfaces)
+				case *Interface:
+					got = interfaceNames(typ.Interfaces)
+				}
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("%s implements %v, want %v", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestPossibleTypes(t *testing.T) {
+	s := New()
+	err := s.Parse([]byte(`
+interface A { a: ID }
+interface B implements A { a: ID b: ID }
+type T implements B { a: ID b: ID }
+type U implements A { a: ID }
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string][]string{"A": {"T", "U"}, "B": {"T"}} {
+		var got []string
+		for _, obj := range s.Types[name].(*Interface).PossibleTypes {
+			got = append(got, obj.Name)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("possible types of %s are %v, want %v", name, got, want)
+		}
+	}
+}
+
+func interfaceNames(list []*Interface) []string {
+	var names []string
+	for _, intf := range list {
+		names = append(names, intf.Name)
+	}
+	return names
+}
@@ -0,0 +1,194 @@
+package graphql
+
+import (
+	"bytes"
+	"fmt"
+	"text/scanner"
+
+	"github.com/nathanborror/startapp/graphql/common"
+)
+
+// Document is an executable GraphQL document, the operations and fragments
+// written in a client's '.graphql' files.
+type Document struct {
+	Operations []*Operation
+	Fragments  []*FragmentDecl
+}
+
+type Operation struct {
+	Type       string // query, mutation or subscription
+	Name       string
+	Vars       common.InputValueList
+	Directives common.DirectiveList
+	Selections []Selection
+}
+
+type FragmentDecl struct {
+	Name       string
+	On         string
+	Directives common.DirectiveList
+	Selections []Selection
+}
+
+type Selection interface {
+	isSelection()
+}
+
+// SelectedField is a field of a selection set, Alias is empty unless the
+// field is renamed in the response.
+type SelectedField struct {
+	Alias      string
+	Name       string
+	Arguments  common.ArgumentList
+	Directives common.DirectiveList
+	Selections []Selection
+}
+
+// InlineFragment selects fields when the value is of the type On, an empty
+// type condition always applies.
+type InlineFragment struct {
+	On         string
+	Directives common.DirectiveList
+	Selections []Selection
+}
+
+type FragmentSpread struct {
+	Name       string
+	Directives common.DirectiveList
+}
+
+func (*SelectedField) isSelection()  {}
+func (*InlineFragment) isSelection() {}
+func (*FragmentSpread) isSelection() {}
+
+// Key returns the name of the field in the response.
+func (f *SelectedField) Key() string {
+	if f.Alias != "" {
+		return f.Alias
+	}
+	return f.Name
+}
+
+// Fragment returns the named fragment of the document.
+func (d *Document) Fragment(name string) *FragmentDecl {
+	for _, frag := range d.Fragments {
+		if frag.Name == name {
+			return frag
+		}
+	}
+	return nil
+}
+
+// ParseDocument parses an executable GraphQL document.
+func ParseDocument(data []byte) (*Document, error) {
+	sc := &scanner.Scanner{
+		Mode: scanner.ScanIdents | scanner.ScanInts | scanner.ScanFloats | scanner.ScanStrings,
+	}
+	sc.Init(bytes.NewReader(data))
+
+	d := &Document{}
+	l := common.New(sc)
+	err := l.CatchSyntaxError(func() {
+		parseDocument(d, l)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func parseDocument(d *Document, l *common.Lexer) {
+	for l.Peek() != scanner.EOF {
+		if l.Peek() == '{' {
+			d.Operations = append(d.Operations, &Operation{Type: "query", Selections: parseSelectionSet(l)})
+			continue
+		}
+		switch x := l.ConsumeIdent(); x {
+		case "query", "mutation", "subscription":
+			d.Operations = append(d.Operations, parseOperation(l, x))
+		case "fragment":
+			d.Fragments = append(d.Fragments, parseFragment(l))
+		default:
+			l.SyntaxError(fmt.Sprintf(`unexpected %q, expecting "query", "mutation", "subscription" or "fragment"`, x))
+		}
+	}
+}
+
+func parseOperation(l *common.Lexer, opType string) *Operation {
+	op := &Operation{Type: opType}
+	if l.Peek() == scanner.Ident {
+		op.Name = l.ConsumeIdent()
+	}
+	if l.Peek() == '(' {
+		l.ConsumeToken('(')
+		for l.Peek() != ')' {
+			l.ConsumeToken('$')
+			op.Vars = append(op.Vars, common.ParseInputValue(l))
+		}
+		l.ConsumeToken(')')
+	}
+	op.Directives = common.ParseDirectives(l)
+	op.Selections = parseSelectionSet(l)
+	return op
+}
+
+func parseFragment(l *common.Lexer) *FragmentDecl {
+	f := &FragmentDecl{}
+	f.Name = l.ConsumeIdent()
+	l.ConsumeKeyword("on")
+	f.On = l.ConsumeIdent()
+	f.Directives = common.ParseDirectives(l)
+	f.Selections = parseSelectionSet(l)
+	return f
+}
+
+func parseSelectionSet(l *common.Lexer) []Selection {
+	var sels []Selection
+	l.ConsumeToken('{')
+	for l.Peek() != '}' {
+		sels = append(sels, parseSelection(l))
+	}
+	l.ConsumeToken('}')
+	return sels
+}
+
+func parseSelection(l *common.Lexer) Selection {
+	if l.Peek() == '.' {
+		return parseSpread(l)
+	}
+	f := &SelectedField{}
+	f.Name = l.ConsumeIdent()
+	if l.Peek() == ':' {
+		l.ConsumeToken(':')
+		f.Alias = f.Name
+		f.Name = l.ConsumeIdent()
+	}
+	if l.Peek() == '(' {
+		f.Arguments = common.ParseArguments(l)
+	}
+	f.Directives = common.ParseDirectives(l)
+	if l.Peek() == '{' {
+		f.Selections = parseSelectionSet(l)
+	}
+	return f
+}
+
+// parseSpread parses a fragment spread `...Name` or an inline fragment
+// `... on Type { }`, the type condition of inline fragments is optional.
+func parseSpread(l *common.Lexer) Selection {
+	l.ConsumeToken('.')
+	l.ConsumeToken('.')
+	l.ConsumeToken('.')
+
+	f := &InlineFragment{}
+	if l.Peek() == scanner.Ident {
+		name := l.ConsumeIdent()
+		if name != "on" {
+			return &FragmentSpread{Name: name, Directives: common.ParseDirectives(l)}
+		}
+		f.On = l.ConsumeIdent()
+	}
+	f.Directives = common.ParseDirectives(l)
+	f.Selections = parseSelectionSet(l)
+	return f
+}
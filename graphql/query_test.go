@@ -0,0 +1,175 @@
+package graphql
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nathanborror/startapp/graphql/common"
+)
+
+func TestParseDocument(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		want     []string // Operations and fragments, printed by printDocument
+		err      bool
+	}{
+		{
+			name:     "shorthand query",
+			document: `{ viewer { id } }`,
+			want:     []string{"query { viewer { id } }"},
+		},
+		{
+			name:     "named operation with variables",
+			document: `query Post($id: ID!) { node(id: $id) { id } }`,
+			want:     []string{"query Post($id) { node(id) { id } }"},
+		},
+		{
+			name:     "multiple operations",
+			document: `query A { viewer { id } } mutation B { logout }`,
+			want:     []string{"query A { viewer { id } }", "mutation B { logout }"},
+		},
+		{
+			name:     "aliases",
+			document: `{ viewer { short: excerpt(length: 10) long: excerpt name } }`,
+			want:     []string{"query { viewer { short:excerpt(length) long:excerpt name } }"},
+		},
+		{
+			name:     "fragment spread",
+			document: `{ viewer { ...Names @include(if: true) } } fragment Names on Account { name }`,
+			want:     []string{"query { viewer { ...Names @include } }", "fragment Names on Account { name }"},
+		},
+		{
+			name:     "inline fragments",
+			document: `{ node(id: "1") { __typename ... on Post { title } ... @skip(if: false) { id } } }`,
+			want:     []string{"query { node(id) { __typename ... on Post { title } ... @skip { id } } }"},
+		},
+		{
+			name:     "directives on fields",
+			document: `{ viewer @include(if: true) { id @skip(if: false) } }`,
+			want:     []string{"query { viewer @include { id @skip } }"},
+		},
+		{
+			name:     "unknown definition",
+			document: `type Account { id: ID }`,
+			err:      true,
+		},
+		{
+			name:     "unclosed selection set",
+			document: `{ viewer { id }`,
+			err:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := ParseDocument([]byte(tt.document))
+			if tt.err {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := printDocument(doc)
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("got:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestSelectedFieldKey(t *testing.T) {
+	doc, err := ParseDocument([]byte(`{ short: excerpt excerpt }`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	for _, sel := range doc.Operations[0].Selections {
+		keys = append(keys, sel.(*SelectedField).Key())
+	}
+	if got := strings.Join(keys, " "); got != "short excerpt" {
+		t.Errorf("keys are %q, want %q", got, "short excerpt")
+	}
+}
+
+func TestDocumentFragment(t *testing.T) {
+	doc, err := ParseDocument([]byte(`fragment A on Account { id } fragment B on Post { id }`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f := doc.Fragment("B"); f == nil || f.On != "Post" {
+		t.Errorf("fragment B is %+v, want one on Post", f)
+	}
+	if f := doc.Fragment("C"); f != nil {
+		t.Errorf("fragment C is %+v, want nil", f)
+	}
+}
+
+// printDocument prints the operations and fragments of a document, one per
+// line. Arguments, variables and directives are printed by name only.
+func printDocument(doc *Document) []string {
+	var out []string
+	for _, op := range doc.Operations {
+		s := op.Type
+		if op.Name != "" {
+			s += " " + op.Name
+		}
+		if len(op.Vars) > 0 {
+			var names []string
+			for _, v := range op.Vars {
+				names = append(names, "$"+v.Name.Name)
+			}
+			s += "(" + strings.Join(names, ", ") + ")"
+		}
+		out = append(out, s+" "+printSelections(op.Selections))
+	}
+	for _, f := range doc.Fragments {
+		out = append(out, "fragment "+f.Name+" on "+f.On+" "+printSelections(f.Selections))
+	}
+	return out
+}
+
+func printSelections(sels []Selection) string {
+	var parts []string
+	for _, sel := range sels {
+		var s string
+		switch sel := sel.(type) {
+		case *SelectedField:
+			if sel.Alias != "" {
+				s = sel.Alias + ":"
+			}
+			s += sel.Name
+			if len(sel.Arguments) > 0 {
+				var names []string
+				for _, arg := range sel.Arguments {
+					names = append(names, arg.Name.Name)
+				}
+				s += "(" + strings.Join(names, ", ") + ")"
+			}
+			s += printDirectives(sel.Directives)
+			if sel.Selections != nil {
+				s += " " + printSelections(sel.Selections)
+			}
+		case *InlineFragment:
+			s = "..."
+			if sel.On != "" {
+				s += " on " + sel.On
+			}
+			s += printDirectives(sel.Directives) + " " + printSelections(sel.Selections)
+		case *FragmentSpread:
+			s = "..." + sel.Name + printDirectives(sel.Directives)
+		}
+		parts = append(parts, s)
+	}
+	return "{ " + strings.Join(parts, " ") + " }"
+}
+
+func printDirectives(directives common.DirectiveList) string {
+	var s string
+	for _, d := range directives {
+		s += " @" + d.Name.Name
+	}
+	return s
+}
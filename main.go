@@ -1,11 +1,21 @@
 package main
 
-//go:generate esc -o gen/templates.go -pkg gen -prefix "/" templates
-
 import (
+	"embed"
+	"io/fs"
+	"log"
+
 	"github.com/nathanborror/startapp/cmd"
 )
 
+//go:embed all:templates
+var templates embed.FS
+
 func main() {
+	fsys, err := fs.Sub(templates, "templates")
+	if err != nil {
+		log.Fatal(err)
+	}
+	cmd.Templates = fsys
 	cmd.Execute()
 }
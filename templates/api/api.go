@@ -1,14 +1,16 @@
 package api
 
 import (
+	"encoding/base64"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"time"
 
-	"github.com/nathanborror/{{.Name}}/api/server"
-	"github.com/nathanborror/{{.Name}}/state"
-	graphql "github.com/neelance/graphql-go"
+	"{{.Module}}/api/server"
+	"{{.Module}}/state"
+	graphql "github.com/graph-gophers/graphql-go"
 )
 
 type Backends struct {
@@ -46,6 +48,8 @@ func readFileContents(filename string) string {
 
 type connectionArgs struct {
 	First  *int32
+	After  *string
+	Last   *int32
 	Before *string
 }
 
@@ -59,14 +63,14 @@ func encodeTime(in time.Time) string {
 	return in.UTC().Format(time.RFC3339)
 }
 
-func encodeCursor(in string) string {
-	return base64.StdEncoding.EncodeToString([]byte(in))
+func encodeCursor(in int) string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("cursor:%d", in)))
 }
 
 func encodePageInfo(in state.PageInfo) *pageInfoResolver {
 	return &pageInfoResolver{
-		startID:     in.StartID,
-		endID:       in.EndID,
+		startCursor: in.StartCursor,
+		endCursor:   in.EndCursor,
 		hasNext:     in.HasNext,
 		hasPrevious: in.HasPrevious,
 	}
@@ -78,12 +82,42 @@ func decodeID(in graphql.ID) string {
 	return string(in)
 }
 
-func decodeCursor(in string) string {
+func decodeCursor(in string) (int, error) {
+	var out int
 	str, err := base64.StdEncoding.DecodeString(in)
 	if err != nil {
-		return ""
+		return 0, fmt.Errorf("Invalid cursor: %s", in)
 	}
-	return string(str)
+	if _, err := fmt.Sscanf(string(str), "cursor:%d", &out); err != nil {
+		return 0, fmt.Errorf("Invalid cursor: %s", in)
+	}
+	return out, nil
+}
+
+func decodeCursorPtr(in *string) (*int, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out, err := decodeCursor(*in)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func decodePage(in connectionArgs) (state.Page, error) {
+	var err error
+	page := state.Page{
+		First: int32toIntPtr(in.First),
+		Last:  int32toIntPtr(in.Last),
+	}
+	if page.After, err = decodeCursorPtr(in.After); err != nil {
+		return page, err
+	}
+	if page.Before, err = decodeCursorPtr(in.Before); err != nil {
+		return page, err
+	}
+	return page, nil
 }
 
 // Conversions
@@ -0,0 +1,14 @@
+package api
+
+import (
+	"testing"
+
+	graphql "github.com/graph-gophers/graphql-go"
+)
+
+func TestSchema(t *testing.T) {
+	root := rootResolver{&Backends{}}
+	if _, err := graphql.ParseSchema(readFileContents("../{{.Schema}}"), &root); err != nil {
+		t.Fatal(err)
+	}
+}
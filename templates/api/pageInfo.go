@@ -1,19 +1,19 @@
 package api
 
 type pageInfoResolver struct {
-	startID     string
-	endID       string
+	startCursor int
+	endCursor   int
 	hasNext     bool
 	hasPrevious bool
 }
 
 func (r *pageInfoResolver) StartCursor() *string {
-	cursor := encodeCursor(r.startID)
+	cursor := encodeCursor(r.startCursor)
 	return &cursor
 }
 
 func (r *pageInfoResolver) EndCursor() *string {
-	cursor := encodeCursor(r.endID)
+	cursor := encodeCursor(r.endCursor)
 	return &cursor
 }
 
@@ -1,15 +1,17 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"strings"
 
-	"github.com/nathanborror/{{.Name}}/pkg/auth"
-	"github.com/nathanborror/{{.Name}}/state"
+	"{{.Module}}/pkg/auth"
+	"{{.Module}}/state"
 
-	graphql "github.com/neelance/graphql-go"
+	graphql "github.com/graph-gophers/graphql-go"
 )
 
 type Handler struct {
@@ -59,6 +61,12 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Add Session to context
 	ctx := session.Context(r.Context())
 
+	// Stream subscriptions as server-sent events
+	if strings.HasPrefix(r.Header.Get("Accept"), "text/event-stream") {
+		h.serveSubscription(ctx, w, args.Query, args.OperationName, args.Variables)
+		return
+	}
+
 	// Execute
 	resp := h.Schema.Exec(ctx, args.Query, args.OperationName, args.Variables)
 
@@ -70,3 +78,34 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.Write(out)
 }
+
+// serveSubscription writes each subscription response to w as a server-sent
+// event until the client disconnects or the subscription completes.
+func (h *Handler) serveSubscription(ctx context.Context, w http.ResponseWriter, query string, operationName string, variables map[string]interface{}) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "RequestBad: Streaming unsupported", 400)
+		return
+	}
+
+	c, err := h.Schema.Subscribe(ctx, query, operationName, variables)
+	if err != nil {
+		http.Error(w, err.Error(), 500)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for resp := range c {
+		out, err := json.Marshal(resp)
+		if err != nil {
+			return
+		}
+		fmt.Fprintf(w, "data: %s\n\n", out)
+		flusher.Flush()
+	}
+}
@@ -0,0 +1,113 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// Client calls the {{.Name|titlecase}} API. Requests are authorized with Token
+// using the Bearer scheme expected by auth.FromRequest.
+type Client struct {
+	Endpoint   string
+	Token      string
+	HTTPClient *http.Client
+}
+
+// New returns a new Client for the given GraphQL endpoint.
+func New(endpoint string, token string) *Client {
+	return &Client{
+		Endpoint:   endpoint,
+		Token:      token,
+		HTTPClient: http.DefaultClient,
+	}
+}
+
+// Error is an error returned by the API.
+type Error struct {
+	Message   string        `json:"message"`
+	Locations []Location    `json:"locations,omitempty"`
+	Path      []interface{} `json:"path,omitempty"`
+}
+
+// Location is the position of an Error in the document.
+type Location struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Errors are all the errors returned by the API for a request.
+type Errors []*Error
+
+func (e Errors) Error() string {
+	var out []string
+	for _, err := range e {
+		out = append(out, err.Message)
+	}
+	return strings.Join(out, "; ")
+}
+
+// Do sends a GraphQL document with its variables and decodes the response
+// data into out. Errors in the response are returned as Errors.
+func (c *Client) Do(ctx context.Context, document string, variables interface{}, out interface{}) error {
+	body, err := json.Marshal(struct {
+		Query     string      `json:"query"`
+		Variables interface{} `json:"variables,omitempty"`
+	}{document, variables})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(data)))
+	}
+
+	var result struct {
+		Data   json.RawMessage `json:"data"`
+		Errors Errors          `json:"errors"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return err
+	}
+	if len(result.Errors) > 0 {
+		return result.Errors
+	}
+	return json.Unmarshal(result.Data, out)
+}
+
+// decodeArgs decodes operation arguments from JSON, missing arguments are
+// left empty.
+func decodeArgs(data json.RawMessage, args interface{}) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, args)
+}
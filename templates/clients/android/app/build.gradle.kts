@@ -0,0 +1,32 @@
+{{ $package := .AndroidClient.PackageName }}
+plugins {
+    id("com.android.application")
+    id("org.jetbrains.kotlin.android")
+}
+
+android {
+    namespace = "{{$package}}"
+    compileSdk = 34
+
+    defaultConfig {
+        applicationId = "{{$package}}"
+        minSdk = 24
+        targetSdk = 34
+        versionCode = 1
+        versionName = "1.0"
+    }
+
+    compileOptions {
+        sourceCompatibility = JavaVersion.VERSION_17
+        targetCompatibility = JavaVersion.VERSION_17
+    }
+
+    kotlinOptions {
+        jvmTarget = "17"
+    }
+}
+
+dependencies {
+    implementation(project(":kit"))
+    implementation("androidx.appcompat:appcompat:1.6.1")
+}
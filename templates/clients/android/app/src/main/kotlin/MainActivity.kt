@@ -0,0 +1,20 @@
+{{ $name := .AndroidClient.Name }}
+{{ $package := .AndroidClient.PackageName }}
+package {{$package}}
+
+import android.os.Bundle
+import android.widget.TextView
+import androidx.appcompat.app.AppCompatActivity
+import {{$package}}.kit.{{$name}}
+
+class MainActivity : AppCompatActivity() {
+
+    override fun onCreate(savedInstanceState: Bundle?) {
+        super.onCreate(savedInstanceState)
+        {{$name}}.start(applicationContext)
+
+        val label = TextView(this)
+        label.text = getString(R.string.app_name)
+        setContentView(label)
+    }
+}
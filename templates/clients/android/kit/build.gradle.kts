@@ -0,0 +1,28 @@
+{{ $package := .AndroidClient.PackageName }}
+plugins {
+    id("com.android.library")
+    id("org.jetbrains.kotlin.android")
+}
+
+android {
+    namespace = "{{$package}}.kit"
+    compileSdk = 34
+
+    defaultConfig {
+        minSdk = 24
+    }
+
+    compileOptions {
+        sourceCompatibility = JavaVersion.VERSION_17
+        targetCompatibility = JavaVersion.VERSION_17
+    }
+
+    kotlinOptions {
+        jvmTarget = "17"
+    }
+}
+
+dependencies {
+    api("com.squareup.okhttp3:okhttp:4.12.0")
+    api("com.google.code.gson:gson:2.10.1")
+}
@@ -0,0 +1,32 @@
+{{ $name := .AndroidClient.Name }}
+{{ $package := .AndroidClient.PackageName }}
+package {{$package}}.kit
+
+import android.content.Context
+import {{$package}}.kit.remote.Remote
+
+object {{$name}} {
+
+    lateinit var current: Service
+        private set
+
+    internal val remote: Remote get() = current.remote
+
+    fun start(context: Context, endpoint: Service.Endpoint = Service.Endpoint.LOCALHOST) {
+        current = Service(context, endpoint)
+    }
+
+    fun replace(service: Service) {
+        current = service
+    }
+}
+
+class Service(context: Context, endpoint: Endpoint) {
+
+    enum class Endpoint(val url: String) {
+        LOCALHOST("http://10.0.2.2:8080/graphql"),
+        PRODUCTION("http://{{.Domain}}/graphql"),
+    }
+
+    internal val remote = Remote(context.applicationContext, endpoint.url)
+}
@@ -0,0 +1,18 @@
+{{ $name := .AndroidClient.Name }}
+pluginManagement {
+    repositories {
+        google()
+        mavenCentral()
+        gradlePluginPortal()
+    }
+}
+
+dependencyResolutionManagement {
+    repositories {
+        google()
+        mavenCentral()
+    }
+}
+
+rootProject.name = "{{$name}}"
+include(":app", ":kit")
@@ -4,8 +4,8 @@ import Foundation
 extension State {
 
     init() {
-        self.account = Account()
-        self.authorization = Authorization()
+        self.authorization = Authorization(){{range .Definition.Objects}}{{if eq .Name "Account"}}
+        self.account = nil{{end}}{{end}}
         self.error = nil
     }
 }
@@ -18,24 +18,3 @@ extension Authorization {
         self.error = nil
     }
 }
-
-extension Account {
-
-    init() {
-        self.id = ""
-        self.name = ""
-        self.email = ""
-        self.created = Date()
-        self.modified = Date()
-        self.error = nil
-    }
-
-    mutating func apply(remote: Remote.Account?) {
-        guard let remote = remote else { return }
-        self.id = remote.id ?? self.id
-        self.name = remote.name ?? self.name
-        self.email = remote.email ?? self.email
-        self.created = Date(rfc3339String: remote.created) ?? self.created
-        self.modified = Date(rfc3339String: remote.modified) ?? self.modified
-    }
-}
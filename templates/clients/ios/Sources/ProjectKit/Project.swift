@@ -1,5 +1,8 @@
 {{ $name := .IOSClient.Name }}
 import Foundation
+#if canImport(FoundationNetworking)
+import FoundationNetworking
+#endif
 
 public let {{$name}}DidChangeNotification = Notification.Name("{{$name}}DidChangeNotification")
 
@@ -35,11 +38,12 @@ public class Service {
 
         if let session = session {
             self.remote = Remote(session: session, endpoint: endpoint.rawValue)
-            return
+        } else {
+            let sessionConfig = URLSessionConfiguration.default
+            let session = URLSession(configuration: sessionConfig, delegate: self.remote, delegateQueue: nil)
+            self.remote = Remote(session: session, endpoint: endpoint.rawValue)
         }
-        let sessionConfig = URLSessionConfiguration.default
-        let session = URLSession(configuration: sessionConfig, delegate: self.remote, delegateQueue: nil)
-        self.remote = Remote(session: session, endpoint: endpoint.rawValue)
+        self.remote.mutations.token = { [weak self] in self?.manager.state.authorization.token }
     }
 
     private func managerStateChanged(state: State) {
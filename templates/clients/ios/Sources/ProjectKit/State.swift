@@ -0,0 +1,21 @@
+{{ $name := .IOSClient.Name }}
+import Foundation
+
+public struct State {
+    public var authorization: Authorization{{range .Definition.Objects}}{{if eq .Name "Account"}}
+    public var account: Account?{{end}}{{end}}
+    public var error: {{$name}}Error?
+}
+
+public struct Authorization {
+    public var token: String?
+    public var stage: Stage
+    public var error: {{$name}}Error?
+
+    public enum Stage {
+        case connected
+        case connecting
+        case registering
+        case disconnected
+    }
+}
@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"{{.Module}}/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var remoteCmd = &cobra.Command{
+	Use:   "remote <operation> [arguments]",
+	Short: "Call a query or mutation on a remote {{.Name|titlecase}} server.",
+	Long: `Calls a query or mutation by name on a remote {{.Name|titlecase}} server
+	and prints the result. Arguments are given as a JSON object and requests are
+	authorized with --token, e.g. remote posts '{"first": 10}'`,
+	Args: cobra.RangeArgs(1, 2),
+	Run:  runRemote,
+}
+
+func init() {
+	RootCmd.AddCommand(remoteCmd)
+	remoteCmd.Flags().String("endpoint", "http://localhost:8080/graphql", "GraphQL endpoint of the server")
+	viper.BindPFlag("endpoint", remoteCmd.Flags().Lookup("endpoint"))
+}
+
+func runRemote(cmd *cobra.Command, args []string) {
+	op, ok := client.Operations[args[0]]
+	if !ok {
+		checkErr(fmt.Errorf("Unknown operation: %s", args[0]))
+	}
+	var data json.RawMessage
+	if len(args) > 1 {
+		data = json.RawMessage(args[1])
+	}
+	c := client.New(viper.GetString("endpoint"), viper.GetString("token"))
+	out, err := op(context.Background(), c, data)
+	checkErr(err)
+	fmt.Println(prettyPrint(out))
+}
@@ -5,16 +5,15 @@ import (
 	"fmt"
 	"os"
 
-	"github.com/nathanborror/{{.Name}}/state"
-	"github.com/nathanborror/{{.Name}}/state/postgres"
+	"{{.Module}}/state"
+	"{{.Module}}/state/postgres"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 var (
-	configFlag   string
-	verboseFlag  bool
-	stateBackend state.Stater
+	configFlag  string
+	verboseFlag bool
 )
 
 // RootCmd affords the main command.
@@ -62,12 +61,16 @@ func initConfig() {
 	if err == nil && verboseFlag {
 		fmt.Println("Using config file:", viper.ConfigFileUsed())
 	}
+}
 
+// openState connects to the configured state backend. Commands that talk to
+// a remote server don't need one.
+func openState() state.Stater {
 	stateCfg := make(map[string]string)
 	stateCfg["Database"] = viper.GetString("database")
 	stateCfg["User"] = viper.GetString("database-user")
 	state.Register("postgres", postgres.NewState)
-	stateBackend = state.NewState(viper.GetString("state"), stateCfg)
+	return state.NewState(viper.GetString("state"), stateCfg)
 }
 
 func checkErr(err error) {
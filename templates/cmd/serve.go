@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"net/http"
 
-	"github.com/nathanborror/{{.Name}}/api"
+	"{{.Module}}/api"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -26,7 +26,7 @@ func init() {
 func runServe(cmd *cobra.Command, args []string) {
 	
 	api.Configure(viper.GetString("schema"), api.Backends{
-		State: stateBackend,
+		State: openState(),
 	})
 	http.HandleFunc("/", indexHandler)
 
@@ -35,5 +35,5 @@ func runServe(cmd *cobra.Command, args []string) {
 }
 
 func indexHandler(w http.ResponseWriter, r *http.Request) {
-	fmt.Fprintf(w, versionString())
+	fmt.Fprint(w, versionString())
 }
\ No newline at end of file
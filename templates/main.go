@@ -1,7 +1,7 @@
 package main
 
 import (
-	"github.com/nathanborror/{{.Name}}/cmd"
+	"{{.Module}}/cmd"
 )
 
 func main() {
@@ -33,9 +33,25 @@ type Applier interface {
 	ApplyTime(created, modified time.Time)
 }
 
-// Options describes options for Record.
+// CursorApplier is the interface that wraps the method for applying a
+// record's position in a paginated Result to the underlying type.
+type CursorApplier interface {
+	ApplyCursor(cursor int)
+}
+
+// Options describes options for Record. Edge limits Fetch to the records
+// that the edges of a kind from a record point to.
 type Options struct {
-	DB *sql.DB
+	DB   *sql.DB
+	Edge *Edge
+}
+
+// Edge describes a relationship of a kind from one record to another, e.g.
+// from a post to each of its comments.
+type Edge struct {
+	FromID string
+	ToID   string
+	Kind   string
 }
 
 // Schema describes the SQL table used to store records.
@@ -52,8 +68,16 @@ CREATE TABLE IF NOT EXISTS record_index (
 	id uuid NOT NULL UNIQUE,
 	datatype varchar(32) NOT NULL
 );
+CREATE TABLE IF NOT EXISTS edge (
+	added_id serial PRIMARY KEY,
+	from_id uuid NOT NULL,
+	to_id uuid NOT NULL,
+	kind varchar(255) NOT NULL,
+	UNIQUE(from_id, to_id, kind)
+);
 CREATE INDEX IF NOT EXISTS record_index_id ON record_index(id);
-CREATE INDEX IF NOT EXISTS record_index_datatype ON record_index(datatype);`
+CREATE INDEX IF NOT EXISTS record_index_datatype ON record_index(datatype);
+CREATE INDEX IF NOT EXISTS edge_from_id_kind ON edge(from_id, kind);`
 
 // NewRecord returns a new Record that wraps data.
 func NewRecord(data Identifier, options Options) *Record {
@@ -128,119 +152,171 @@ func History(id string, options Options) *Result {
 	return &result
 }
 
-// Fetch returns a paginated list of Records.
-func Fetch(datatype string, first *int, beforeID *string, options Options) *Result {
+// Page describes a slice of a datatype's records ordered from newest to
+// oldest. After and Before are cursors returned by a previous Result.
+type Page struct {
+	First  *int
+	After  *int
+	Last   *int
+	Before *int
+}
+
+// Connect stores an edge between two records, connecting them again is a
+// no-op.
+func Connect(edge Edge, options Options) error {
+	_, err := options.DB.Exec(`
+		INSERT INTO edge (from_id, to_id, kind)
+		VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`, edge.FromID, edge.ToID, edge.Kind)
+	return err
+}
+
+// Disconnect removes an edge between two records.
+func Disconnect(edge Edge, options Options) error {
+	_, err := options.DB.Exec(`
+		DELETE FROM edge
+		WHERE from_id = $1 AND to_id = $2 AND kind = $3`, edge.FromID, edge.ToID, edge.Kind)
+	return err
+}
+
+// Fetch returns a paginated list of Records following Relay's connection
+// semantics: 'first' and 'after' page forwards through the records while
+// 'last' and 'before' page backwards. When options has an Edge only the
+// records its edges point to are paginated.
+func Fetch(datatype string, page Page, options Options) *Result {
 	var (
-		beforeAddedID int
-		beforeCount   int
-		afterCount    int
-		latestID      int
-		ids           []string
-		result        Result
+		entries []indexEntry
+		result  Result
 	)
 
-	// Require 'first' to be greater than 0
-	if first == nil || first != nil && *first == 0 {
-		result.err = fmt.Errorf("No results returned because 'first' was nil")
+	// Require 'first' or 'last' to be greater than 0
+	if !isPositive(page.First) && !isPositive(page.Last) {
+		result.err = fmt.Errorf("No results returned because 'first' and 'last' were nil")
 		return &result
 	}
 
-	// Fetch total
-	result.err = options.DB.QueryRow(`SELECT COUNT(*) FROM record_index WHERE datatype = $1`, datatype).Scan(&result.Total)
-	if result.err != nil {
-		result.err = fmt.Errorf("Error fetching total: %v", result.err)
-		return &result
+	// Scope the records to the datatype and the edge's records
+	scope := []string{"datatype = $1"}
+	scopeArgs := []interface{}{datatype}
+	if options.Edge != nil {
+		scopeArgs = append(scopeArgs, options.Edge.FromID, options.Edge.Kind)
+		scope = append(scope, "id IN (SELECT to_id FROM edge WHERE from_id = $2 AND kind = $3)")
 	}
 
-	// Determine added_id of latest record
-	result.err = options.DB.QueryRow(`
-		SELECT (added_id) FROM record_index
-		WHERE datatype = $1 ORDER BY added_id DESC LIMIT 1`, datatype).Scan(&latestID)
-	if result.err == sql.ErrNoRows {
-		result.err = nil
-		return &result
-	}
+	// Fetch total
+	result.err = options.DB.QueryRow(`SELECT COUNT(*) FROM record_index WHERE `+strings.Join(scope, " AND "), scopeArgs...).Scan(&result.Total)
 	if result.err != nil {
-		result.err = fmt.Errorf("Error fetching latest ID: %v", result.err)
+		result.err = fmt.Errorf("Error fetching total: %v", result.err)
 		return &result
 	}
 
-	// Determine the added_id for the beforeID record
-	if beforeID != nil {
-		result.err = options.DB.QueryRow(`
-			SELECT (added_id) FROM record_index 
-			WHERE id = $1 AND datatype = $2`, beforeID, datatype).Scan(&beforeAddedID)
-	} else {
-		beforeAddedID = latestID + 1 // Increment so latest record is included
+	// Bound the page by its cursors
+	args := append([]interface{}{}, scopeArgs...)
+	where := append([]string{}, scope...)
+	if page.After != nil {
+		args = append(args, *page.After)
+		where = append(where, fmt.Sprintf("added_id < $%d", len(args)))
 	}
-	if result.err != nil {
-		result.err = fmt.Errorf("Error fetching added ID: %v", result.err)
-		return &result
+	if page.Before != nil {
+		args = append(args, *page.Before)
+		where = append(where, fmt.Sprintf("added_id > $%d", len(args)))
 	}
 
-	// Determine how may records exist before and after the beforeAddedID
-	if beforeAddedID != 0 {
-		result.err = options.DB.QueryRow(`
-			SELECT
-				(SELECT COUNT(added_id) FROM record_index
-					WHERE added_id < $1 AND datatype = $2) AS beforeCount,
-				(SELECT COUNT(added_id) FROM record_index
-					WHERE added_id > $1 AND datatype = $2) AS afterCount
-			`, beforeAddedID, datatype).Scan(&beforeCount, &afterCount)
+	// Fetch Record IDs from index, reading from the oldest records when
+	// paging backwards
+	order, limit := "DESC", page.First
+	if !isPositive(page.First) {
+		order, limit = "ASC", page.Last
 	}
-
-	// Fetch Record IDs from index
-	rows, err := options.DB.Query(`
-		SELECT id FROM record_index 
-		WHERE added_id < $1 
-		AND datatype = $2 
-		ORDER BY added_id DESC
-		LIMIT $3`, beforeAddedID, datatype, first)
+	args = append(args, *limit)
+	rows, err := options.DB.Query(fmt.Sprintf(`
+		SELECT added_id, id FROM record_index
+		WHERE %s
+		ORDER BY added_id %s
+		LIMIT $%d`, strings.Join(where, " AND "), order, len(args)), args...)
 	if err != nil {
-		result.err = fmt.Errorf("Error fetching records before (%d): %v", beforeAddedID, err)
+		result.err = fmt.Errorf("Error fetching records: %v", err)
 		return &result
 	}
 	defer rows.Close()
 	for rows.Next() {
-		var id string
-		if err := rows.Scan(&id); err != nil {
+		var entry indexEntry
+		if err := rows.Scan(&entry.cursor, &entry.id); err != nil {
 			result.err = err
 			return &result
 		}
-		ids = append(ids, id)
+		entries = append(entries, entry)
 	}
 	if err := rows.Err(); err != nil {
 		result.err = err
 		return &result
 	}
-	result.StartID = ids[0]
-	result.EndID = ids[len(ids)-1]
+	if order == "ASC" {
+		for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+			entries[i], entries[j] = entries[j], entries[i]
+		}
+	}
+	if isPositive(page.First) && isPositive(page.Last) && len(entries) > *page.Last {
+		entries = entries[len(entries)-*page.Last:]
+	}
 
-	// Fetch Records
+	// Determine whether records exist on either side of the page
+	if len(entries) > 0 {
+		result.StartID, result.StartCursor = entries[0].id, entries[0].cursor
+		result.EndID, result.EndCursor = entries[len(entries)-1].id, entries[len(entries)-1].cursor
+		result.HasPrevious, result.err = exists(scope, scopeArgs, ">", result.StartCursor, options)
+		if result.err == nil {
+			result.HasNext, result.err = exists(scope, scopeArgs, "<", result.EndCursor, options)
+		}
+	} else {
+		if page.After != nil {
+			result.HasPrevious, result.err = exists(scope, scopeArgs, ">=", *page.After, options)
+		}
+		if page.Before != nil && result.err == nil {
+			result.HasNext, result.err = exists(scope, scopeArgs, "<=", *page.Before, options)
+		}
+	}
+	if result.err != nil {
+		result.err = fmt.Errorf("Error fetching page info: %v", result.err)
+		return &result
+	}
+	if len(entries) == 0 {
+		return &result
+	}
+
+	// Fetch Records and order them to match the index
+	ids := make([]string, len(entries))
+	for i, entry := range entries {
+		ids[i] = entry.id
+	}
 	records := FetchIn(ids, options)
 	if err := records.Err(); err != nil {
 		result.err = err
 		return &result
 	}
-	result.Records = records.Records
-
-	if beforeAddedID != 0 {
-		result.HasNext = afterCount > 0
-		result.HasPrevious = beforeCount > len(result.Records)
-	} else {
-		result.HasPrevious = result.Total > len(result.Records)
-		result.HasNext = false
+	byID := make(map[string]Record, len(records.Records))
+	for _, r := range records.Records {
+		byID[r.ID] = r
+	}
+	for _, entry := range entries {
+		r, ok := byID[entry.id]
+		if !ok {
+			continue
+		}
+		r.Cursor = entry.cursor
+		result.Records = append(result.Records, r)
 	}
 	return &result
 }
 
-// FetchIn returns a Result with records for a given set of IDs.
+// FetchIn returns a Result with the latest version of the records for a given
+// set of IDs.
 func FetchIn(recordIDs []string, options Options) *Result {
 	var result Result
 	any := fmt.Sprintf("{%s}", strings.Join(recordIDs, ","))
 	rows, err := options.DB.Query(`
-		SELECT added_id, id, datatype, data, time FROM record 
-		WHERE id = ANY($1)`, any)
+		SELECT DISTINCT ON (id) added_id, id, datatype, data, time FROM record 
+		WHERE id = ANY($1)
+		ORDER BY id, time DESC`, any)
 	if err != nil {
 		result.err = err
 		return &result
@@ -259,6 +335,16 @@ func FetchIn(recordIDs []string, options Options) *Result {
 	return &result
 }
 
+// DataType returns the datatype of the indexed record for a given ID.
+func DataType(id string, options Options) (string, error) {
+	var datatype string
+	err := options.DB.QueryRow(`SELECT datatype FROM record_index WHERE id = $1`, id).Scan(&datatype)
+	if err == sql.ErrNoRows {
+		return "", ErrRecordNotFound
+	}
+	return datatype, err
+}
+
 // QueryRecord returns a single record for a given custom query. Like Query()
 // it expects the statement to return added_id, id, datatype, data and time in
 // that order.
@@ -281,6 +367,8 @@ type Result struct {
 	HasPrevious bool
 	StartID     string
 	EndID       string
+	StartCursor int
+	EndCursor   int
 	err         error
 }
 
@@ -324,6 +412,7 @@ type Record struct {
 	Data     json.RawMessage
 	Time     time.Time
 	ID       string
+	Cursor   int // Index position, only set by Fetch
 }
 
 // Read returns an existing Record that matches id.
@@ -414,6 +503,9 @@ func (r *Record) Scan(v Applier) {
 	}
 	v.ApplyID(r.ID)
 	v.ApplyTime(created, r.Time)
+	if c, ok := v.(CursorApplier); ok && r.Cursor != 0 {
+		c.ApplyCursor(r.Cursor)
+	}
 }
 
 // Err returns the first error that was encountered by the Record.
@@ -441,6 +533,27 @@ func requireValidQuery(q string) error {
 	return nil
 }
 
+type indexEntry struct {
+	cursor int
+	id     string
+}
+
+// exists reports whether a record in scope is on the side of a cursor given
+// by op, e.g. ">" for newer records.
+func exists(scope []string, scopeArgs []interface{}, op string, cursor int, options Options) (bool, error) {
+	var out bool
+	args := append(append([]interface{}{}, scopeArgs...), cursor)
+	where := append(append([]string{}, scope...), fmt.Sprintf("added_id %s $%d", op, len(args)))
+	err := options.DB.QueryRow(fmt.Sprintf(`
+		SELECT EXISTS(SELECT 1 FROM record_index
+			WHERE %s)`, strings.Join(where, " AND ")), args...).Scan(&out)
+	return out, err
+}
+
+func isPositive(in *int) bool {
+	return in != nil && *in > 0
+}
+
 func hasError(r *Record) bool {
 	return r.err != nil
 }
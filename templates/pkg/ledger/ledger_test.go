@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"log"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -32,7 +33,7 @@ func TestMain(m *testing.M) {
 	code := m.Run()
 
 	// Teardown
-	if _, err := db.Exec(`DROP TABLE record, record_index`); err != nil {
+	if _, err := db.Exec(`DROP TABLE record, record_index, edge`); err != nil {
 		log.Fatal(err)
 	}
 
@@ -44,6 +45,7 @@ const MockDataType = "mock.account"
 type MockAccount struct {
 	ID       string
 	Name     string
+	Cursor   int
 	Created  time.Time
 	Modified time.Time
 }
@@ -55,6 +57,7 @@ func (i *MockAccount) ApplyTime(created, modified time.Time) {
 	i.Created = created
 	i.Modified = modified
 }
+func (i *MockAccount) ApplyCursor(cursor int) { i.Cursor = cursor }
 
 func (i *MockAccount) IsNotEqual(m MockAccount) bool {
 	return i.ID != m.ID ||
@@ -156,6 +159,20 @@ func TestQueryRecord(t *testing.T) {
 	}
 }
 
+func TestDataType(t *testing.T) {
+	datatype, err := DataType(testAccount.ID, testOptions)
+	if err != nil {
+		t.Error(err)
+	}
+	if datatype != MockDataType {
+		t.Errorf("datatype != %s (%s)", MockDataType, datatype)
+	}
+
+	if _, err := DataType("00000000-0000-0000-0000-000000000000", testOptions); err != ErrRecordNotFound {
+		t.Errorf("err != ErrRecordNotFound (%v)", err)
+	}
+}
+
 func TestInvalidQuery(t *testing.T) {
 	rec1 := QueryRecord(`
 		SELECT id FROM record WHERE id = $1
@@ -237,39 +254,140 @@ func TestFetchRecords(t *testing.T) {
 		}
 	}
 	first := 2
-	res := Fetch(MockDataType, &first, nil, testOptions)
+	res := Fetch(MockDataType, Page{First: &first}, testOptions)
 	if err := res.Err(); err != nil {
 		t.Error(err)
 	}
 	if len(res.Records) != 2 {
-		t.Errorf("records != 2 (%d)", len(res.Records))
+		t.Fatalf("records != 2 (%d)", len(res.Records))
 	}
 	if res.Total != 5 {
 		t.Errorf("total != 5 (%d)", res.Total)
 	}
+	if res.HasPrevious != false {
+		t.Errorf("hasPrevious != false")
+	}
+	if res.HasNext != true {
+		t.Errorf("HasNext != true")
+	}
+	if names := fetchedNames(res); names != "Test 4,Test 3" {
+		t.Errorf("records out of order (%s)", names)
+	}
+
+	// Next page
+	first = 4
+	res = Fetch(MockDataType, Page{First: &first, After: &res.EndCursor}, testOptions)
+	if err := res.Err(); err != nil {
+		t.Error(err)
+	}
+	if names := fetchedNames(res); names != "Test 2,Test 1,Test 0" {
+		t.Errorf("records out of order (%s)", names)
+	}
 	if res.HasPrevious != true {
 		t.Errorf("hasPrevious != true")
 	}
 	if res.HasNext != false {
 		t.Errorf("HasNext != false")
 	}
-	if res.Records[0].ID == res.Records[1].ID {
-		t.Errorf("records are duplicated")
+
+	// Previous page
+	last := 1
+	res = Fetch(MockDataType, Page{Last: &last, Before: &res.StartCursor}, testOptions)
+	if err := res.Err(); err != nil {
+		t.Error(err)
+	}
+	if names := fetchedNames(res); names != "Test 3" {
+		t.Errorf("records out of order (%s)", names)
+	}
+	if res.HasPrevious != true {
+		t.Errorf("hasPrevious != true")
+	}
+	if res.HasNext != true {
+		t.Errorf("HasNext != true")
 	}
 
-	// Next page
-	first = 4
-	res = Fetch(MockDataType, &first, &res.EndID, testOptions)
+	// Last page
+	last = 2
+	res = Fetch(MockDataType, Page{Last: &last}, testOptions)
 	if err := res.Err(); err != nil {
 		t.Error(err)
 	}
-	if len(res.Records) == 0 {
-		t.Errorf("records == 0")
+	if names := fetchedNames(res); names != "Test 1,Test 0" {
+		t.Errorf("records out of order (%s)", names)
 	}
-	if res.HasPrevious != false {
-		t.Errorf("hasPrevious != false")
+	if res.HasPrevious != true {
+		t.Errorf("hasPrevious != true")
+	}
+	if res.HasNext != false {
+		t.Errorf("HasNext != false")
+	}
+}
+
+func TestFetchEdges(t *testing.T) {
+	parent := MockAccount{Name: "Parent"}
+	rec := NewRecord(&parent, testOptions)
+	rec.Write()
+	rec.Scan(&parent)
+	if err := rec.Err(); err != nil {
+		t.Fatal(err)
+	}
+	for _, mock := range []MockAccount{
+		{Name: "Child 0"},
+		{Name: "Child 1"},
+		{Name: "Other"},
+	} {
+		rec := NewRecord(&mock, testOptions)
+		rec.Write()
+		rec.Scan(&mock)
+		if err := rec.Err(); err != nil {
+			t.Fatal(err)
+		}
+		if mock.Name == "Other" {
+			continue
+		}
+		if err := Connect(Edge{FromID: parent.ID, ToID: mock.ID, Kind: "children"}, testOptions); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	first := 1
+	options := Options{DB: testOptions.DB, Edge: &Edge{FromID: parent.ID, Kind: "children"}}
+	res := Fetch(MockDataType, Page{First: &first}, options)
+	if err := res.Err(); err != nil {
+		t.Error(err)
+	}
+	if res.Total != 2 {
+		t.Errorf("total != 2 (%d)", res.Total)
 	}
 	if res.HasNext != true {
 		t.Errorf("HasNext != true")
 	}
+	if names := fetchedNames(res); names != "Child 1" {
+		t.Errorf("records out of order (%s)", names)
+	}
+
+	// Next page
+	res = Fetch(MockDataType, Page{First: &first, After: &res.EndCursor}, options)
+	if err := res.Err(); err != nil {
+		t.Error(err)
+	}
+	if res.HasNext != false {
+		t.Errorf("HasNext != false")
+	}
+	if names := fetchedNames(res); names != "Child 0" {
+		t.Errorf("records out of order (%s)", names)
+	}
+}
+
+func fetchedNames(res *Result) string {
+	var names []string
+	for res.Next() {
+		var mock MockAccount
+		res.Scan(&mock)
+		if mock.Cursor == 0 {
+			return "missing cursor"
+		}
+		names = append(names, mock.Name)
+	}
+	return strings.Join(names, ",")
 }
@@ -1,14 +1,15 @@
 package postgres
 
 import (
+	"database/sql"
+	"fmt"
 	"log"
 	"strings"
 	"time"
-	"fmt"
 
 	"github.com/jmoiron/sqlx"
-	"github.com/nathanborror/{{.Name}}/pkg/ledger"
-	"github.com/nathanborror/{{.Name}}/state"
+	"{{.Module}}/pkg/ledger"
+	"{{.Module}}/state"
 
 	_ "github.com/lib/pq" // postgres backend
 )
@@ -36,13 +37,6 @@ func NewState(cfg map[string]string) state.Stater {
 	}
 	db.MustExec(ledger.Schema)
 	db.MustExec(`
-		CREATE TABLE IF NOT EXISTS edge (
-			added_id serial PRIMARY KEY,
-			from_id uuid NOT NULL,
-			to_id uuid NOT NULL,
-			kind varchar(255) NOT NULL,
-			UNIQUE(from_id, to_id, kind)
-		);
 		CREATE TABLE IF NOT EXISTS index_account_email (
 			added_id serial PRIMARY KEY,
 			account_id uuid NOT NULL UNIQUE,
@@ -66,9 +60,16 @@ func (m *manager) WriteAuthToken(accountID string, token string) error {
 	return fmt.Errorf("Not Implemented")
 }
 
+// Node Stater
+
+func (m *manager) ReadNodeType(id string) (string, error) {
+	datatype, err := ledger.DataType(id, ledger.Options{DB: m.db.DB})
+	return datatype, wrapErr(err)
+}
+
 // Account Stater
 
-func (m *manager) FetchAccounts(first int, after string) (*state.Accounts, error) {
+func (m *manager) FetchAccounts(page state.Page) (*state.Accounts, error) {
 	return nil, fmt.Errorf("Not Implemented")
 }
 
@@ -88,8 +89,8 @@ func (m *manager) DeleteAccount(accountID string) error {
 	return fmt.Errorf("Not Implemented")
 }
 
-func (m *manager) HistoryForAccount(accountID string) (*state.Accounts, error) {
-	return nil, fmt.Errorf("Not Implemented")
+func (m *manager) HistoryForAccount(accountID string) (state.Accounts, error) {
+	return state.Accounts{}, fmt.Errorf("Not Implemented")
 }
 
 func (m *manager) RestoreAccount(accountID string, at time.Time) (*state.Account, error) {
@@ -18,6 +18,9 @@ var (
 // Stater is the interface that wraps all Stater interfaces.
 type Stater interface {
 	AuthStater
+	NodeStater
+	ConnectionStater
+	ObjectStater
 	AccountStater
 }
 
@@ -26,9 +29,14 @@ type AuthStater interface {
 	WriteAuthToken(accountID string, token string) error
 }
 
+// NodeStater is the interface that wraps Node lookups.
+type NodeStater interface {
+	ReadNodeType(id string) (string, error)
+}
+
 // AccountStater is the interface that wraps Account I/O.
 type AccountStater interface {
-	FetchAccounts(first int, after string) (*Accounts, error)
+	FetchAccounts(page Page) (*Accounts, error)
 	ReadAccount(accountID string) (*Account, error)
 	ReadAccountForEmail(email string) (*Account, error)
 	WriteAccount(in *Account, password string) (*Account, error)
@@ -2,34 +2,12 @@ package state
 
 import "time"
 
-const (
-	AccountDataType = "run.nathan.{{.Name}}.account"
-)
-
-// Account represents an Account object.
-type Account struct {
-	Name     string
-	Email    string
-	Password string
-	IsActive bool
-	Status   AccountStatus
-	Node
-}
-
 // Accounts represents a collection of Accounts.
 type Accounts struct {
 	Results []Account
 	PageInfo
 }
 
-type AccountStatus string
-
-const (
-	AccountReader    AccountStatus = "READER"
-	AccountModerator               = "MODERATOR"
-	AccountSuperuser               = "SUPERUSER"
-)
-
 // Node represents an abstract Node.
 type Node struct {
 	Id       string
@@ -45,6 +23,15 @@ type Edge struct {
 	Kind   string
 }
 
+// Page represents a request for a slice of a connection. First and After
+// page forwards, Last and Before page backwards.
+type Page struct {
+	First  *int
+	After  *int
+	Last   *int
+	Before *int
+}
+
 // PageInfo represents paging information.
 type PageInfo struct {
 	Total       int
@@ -52,6 +39,8 @@ type PageInfo struct {
 	HasPrevious bool
 	StartID     string
 	EndID       string
+	StartCursor int
+	EndCursor   int
 }
 
 // Conformance
@@ -63,5 +52,3 @@ func (i *Node) ApplyTime(created, modified time.Time) {
 	i.Created = created
 	i.Modified = modified
 }
-
-func (i *Account) IdentifyType() string { return AccountDataType }